@@ -0,0 +1,153 @@
+// Package adf converts between Markdown and the Atlassian Document Format (ADF),
+// the JSON document model JIRA Cloud REST API v3 uses for descriptions and comments
+//
+// Supported Markdown: headings, paragraphs, bullet and ordered lists (nested),
+// fenced code blocks, blockquotes, horizontal rules, GFM tables, links,
+// **strong**, *em*, ~~strike~~, `code` and mentions written as
+// @[Display Name](accountid:5b10ac8d82e05b22cc7d4ef5)
+package adf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Node types
+const (
+	TypeDoc         = "doc"
+	TypeParagraph   = "paragraph"
+	TypeHeading     = "heading"
+	TypeBulletList  = "bulletList"
+	TypeOrderedList = "orderedList"
+	TypeListItem    = "listItem"
+	TypeCodeBlock   = "codeBlock"
+	TypeBlockquote  = "blockquote"
+	TypeRule        = "rule"
+	TypeTable       = "table"
+	TypeTableRow    = "tableRow"
+	TypeTableHeader = "tableHeader"
+	TypeTableCell   = "tableCell"
+	TypeTaskList    = "taskList"
+	TypeTaskItem    = "taskItem"
+	TypeText        = "text"
+	TypeHardBreak   = "hardBreak"
+	TypeMention     = "mention"
+	TypeEmoji       = "emoji"
+	TypeInlineCard  = "inlineCard"
+	TypeStatus      = "status"
+	TypeDate        = "date"
+)
+
+// Mark types
+const (
+	MarkStrong = "strong"
+	MarkEm     = "em"
+	MarkCode   = "code"
+	MarkStrike = "strike"
+	MarkLink   = "link"
+
+	MarkUnderline = "underline" // No Markdown equivalent; dropped by ToMarkdown
+)
+
+// mentionScheme prefixes the link destination of a Markdown mention
+const mentionScheme = "accountid:"
+
+// Node is an ADF node; a document is a Node of type "doc"
+type Node struct {
+	Type    string                 `json:"type"`
+	Version int                    `json:"version,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`
+	Content []*Node                `json:"content,omitempty"`
+	Text    string                 `json:"text,omitempty"`
+	Marks   []Mark                 `json:"marks,omitempty"`
+}
+
+// Mark is formatting applied to a text node
+type Mark struct {
+	Type  string                 `json:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// NewDocument creates an ADF document with the given block content
+func NewDocument(content ...*Node) *Node {
+	if content == nil {
+		content = []*Node{}
+	}
+	return &Node{Type: TypeDoc, Version: 1, Content: content}
+}
+
+// FromText converts plain text to an ADF document without interpreting any markup
+// Blank lines separate paragraphs and other line breaks become hard breaks
+func FromText(text string) *Node {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	var blocks []*Node
+	for _, para := range strings.Split(text, "\n\n") {
+		if strings.TrimSpace(para) == "" {
+			continue
+		}
+		paragraph := &Node{Type: TypeParagraph}
+		for i, line := range strings.Split(para, "\n") {
+			if i > 0 {
+				paragraph.Content = append(paragraph.Content, &Node{Type: TypeHardBreak})
+			}
+			if line != "" {
+				paragraph.Content = append(paragraph.Content, &Node{Type: TypeText, Text: line})
+			}
+		}
+		blocks = append(blocks, paragraph)
+	}
+
+	return NewDocument(blocks...)
+}
+
+// Parse decodes an ADF document from JSON
+func Parse(data []byte) (*Node, error) {
+	var doc Node
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse ADF document: %w", err)
+	}
+	return &doc, nil
+}
+
+// AttrString returns a string attribute, or "" when it is missing
+func (n *Node) AttrString(key string) string {
+	if v, ok := n.Attrs[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// AttrInt returns a numeric attribute, or def when it is missing
+func (n *Node) AttrInt(key string, def int) int {
+	switch v := n.Attrs[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return def
+}
+
+// IsInline reports whether a node type appears inside paragraphs rather than as a block
+func IsInline(nodeType string) bool {
+	switch nodeType {
+	case TypeText, TypeHardBreak, TypeMention, TypeEmoji, TypeInlineCard, TypeStatus, TypeDate:
+		return true
+	}
+	return false
+}
+
+// sameMarks reports whether two mark sets are identical
+func sameMarks(a, b []Mark) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Type != b[i].Type || fmt.Sprint(a[i].Attrs) != fmt.Sprint(b[i].Attrs) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,160 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFromMarkdownStructure(t *testing.T) {
+	doc := FromMarkdown("## Steps\n1. Open *app*\n2. Click [here](https://example.com)\n   - nested\n\n```go\nfmt.Println(1)\n```\n\n| A | B |\n|---|---|\n| 1 | 2 |\n\nPing @[Jane Doe](accountid:abc123)")
+
+	if doc.Type != TypeDoc || doc.Version != 1 {
+		t.Fatalf("FromMarkdown() root = %s v%d, want doc v1", doc.Type, doc.Version)
+	}
+
+	var types []string
+	for _, block := range doc.Content {
+		types = append(types, block.Type)
+	}
+	want := []string{TypeHeading, TypeOrderedList, TypeCodeBlock, TypeTable, TypeParagraph}
+	if len(types) != len(want) {
+		t.Fatalf("FromMarkdown() blocks = %v, want %v", types, want)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Errorf("block %d = %s, want %s", i, types[i], want[i])
+		}
+	}
+
+	list := doc.Content[1]
+	second := list.Content[1]
+	if len(second.Content) != 2 || second.Content[1].Type != TypeBulletList {
+		t.Errorf("second list item should contain a nested bullet list, got %+v", second.Content)
+	}
+	link := second.Content[0].Content[1]
+	if link.Text != "here" || len(link.Marks) != 1 || link.Marks[0].Attrs["href"] != "https://example.com" {
+		t.Errorf("link node = %+v, want text 'here' linking to https://example.com", link)
+	}
+
+	code := doc.Content[2]
+	if code.AttrString("language") != "go" || code.Content[0].Text != "fmt.Println(1)" {
+		t.Errorf("code block = %+v, want go code", code)
+	}
+
+	header := doc.Content[3].Content[0].Content[0]
+	if header.Type != TypeTableHeader {
+		t.Errorf("first table row cell = %s, want %s", header.Type, TypeTableHeader)
+	}
+
+	mention := doc.Content[4].Content[1]
+	if mention.Type != TypeMention || mention.AttrString("id") != "abc123" || mention.AttrString("text") != "@Jane Doe" {
+		t.Errorf("mention node = %+v, want id abc123 text @Jane Doe", mention)
+	}
+}
+
+func TestInlineMarks(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		text  string
+		marks []string
+	}{
+		{"Strong", "**bold**", "bold", []string{MarkStrong}},
+		{"Em with star", "*it*", "it", []string{MarkEm}},
+		{"Em with underscore", "_it_", "it", []string{MarkEm}},
+		{"Strike", "~~gone~~", "gone", []string{MarkStrike}},
+		{"Code", "`x := 1`", "x := 1", []string{MarkCode}},
+		{"Nested", "**[link](http://a.b)**", "link", []string{MarkStrong, MarkLink}},
+		{"Snake case is literal", "snake_case_name", "snake_case_name", nil},
+		{"Escaped star", `\*literal\*`, "*literal*", nil},
+		{"Unclosed star", "2 * 3", "2 * 3", nil},
+		{"Stars inside words are literal", "2*3*4", "2*3*4", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := parseInline(tt.input, nil)
+			if len(nodes) != 1 {
+				t.Fatalf("parseInline(%q) = %d nodes, want 1", tt.input, len(nodes))
+			}
+			if nodes[0].Text != tt.text {
+				t.Errorf("parseInline(%q) text = %q, want %q", tt.input, nodes[0].Text, tt.text)
+			}
+			if len(nodes[0].Marks) != len(tt.marks) {
+				t.Fatalf("parseInline(%q) marks = %+v, want %v", tt.input, nodes[0].Marks, tt.marks)
+			}
+			for i, mark := range tt.marks {
+				if nodes[0].Marks[i].Type != mark {
+					t.Errorf("parseInline(%q) mark %d = %s, want %s", tt.input, i, nodes[0].Marks[i].Type, mark)
+				}
+			}
+		})
+	}
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+	}{
+		{"Paragraph", "Plain text"},
+		{"Hard breaks", "line one\nline two"},
+		{"Headings", "# Title\n\n### Section"},
+		{"Marks", "Some **bold**, *em*, ~~strike~~ and `code`"},
+		{"Link", "See [the docs](https://example.com/docs)"},
+		{"Mention", "Thanks @[Jane Doe](accountid:5b10ac8d82e05b22cc7d4ef5)"},
+		{"Bullet list", "- one\n- two\n  - nested"},
+		{"Ordered list", "3. three\n4. four"},
+		{"Code block", "```python\nprint('hi')\n\nprint('bye')\n```"},
+		{"Blockquote", "> quoted\n>\n> second"},
+		{"Rule", "above\n\n---\n\nbelow"},
+		{"Table", "| Name | Value |\n| --- | --- |\n| a | 1 |\n| b \\| c | 2 |"},
+		{"Escapes", `Use \*stars\* and \_underscores_`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := FromMarkdown(tt.markdown)
+
+			// The document must survive JSON encoding as sent to the API
+			data, err := json.Marshal(doc)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			parsed, err := Parse(data)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got := ToMarkdown(parsed); got != tt.markdown {
+				t.Errorf("round trip = %q, want %q", got, tt.markdown)
+			}
+		})
+	}
+}
+
+func TestToMarkdownUnsupportedNodes(t *testing.T) {
+	doc := &Node{Type: TypeDoc, Content: []*Node{
+		{Type: "panel", Content: []*Node{
+			{Type: TypeParagraph, Content: []*Node{
+				{Type: TypeEmoji, Attrs: map[string]interface{}{"shortName": ":smile:", "text": "😄"}},
+				{Type: TypeText, Text: " "},
+				{Type: TypeStatus, Attrs: map[string]interface{}{"text": "DONE"}},
+				{Type: TypeText, Text: " "},
+				{Type: TypeInlineCard, Attrs: map[string]interface{}{"url": "https://example.com"}},
+			}},
+		}},
+		{Type: "mediaSingle", Content: []*Node{{Type: "media"}}},
+	}}
+
+	want := "😄 DONE <https://example.com>"
+	if got := ToMarkdown(doc); got != want {
+		t.Errorf("ToMarkdown() = %q, want %q", got, want)
+	}
+}
+
+func TestToMarkdownNil(t *testing.T) {
+	if got := ToMarkdown(nil); got != "" {
+		t.Errorf("ToMarkdown(nil) = %q, want empty", got)
+	}
+}
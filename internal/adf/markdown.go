@@ -0,0 +1,548 @@
+package adf
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	headingPattern        = regexp.MustCompile(`^(#{1,6})(?:\s+(.*?))?\s*#*\s*$`)
+	rulePattern           = regexp.MustCompile(`^(?:(?:\*\s*){3,}|(?:-\s*){3,}|(?:_\s*){3,})$`)
+	listMarkerPattern     = regexp.MustCompile(`^( *)([-*+]|\d{1,9}[.)])(?:( +)(.*))?$`)
+	tableSeparatorPattern = regexp.MustCompile(`^\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?$`)
+)
+
+// FromMarkdown converts Markdown text to an ADF document
+func FromMarkdown(markdown string) *Node {
+	markdown = strings.ReplaceAll(markdown, "\r\n", "\n")
+	lines := strings.Split(markdown, "\n")
+	for i, line := range lines {
+		lines[i] = expandLeadingTabs(line)
+	}
+	return NewDocument(parseBlocks(lines)...)
+}
+
+// parseBlocks parses a sequence of lines into block nodes
+func parseBlocks(lines []string) []*Node {
+	var blocks []*Node
+
+	for i := 0; i < len(lines); {
+		trimmed := strings.TrimSpace(lines[i])
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case isFence(trimmed):
+			var node *Node
+			node, i = parseCodeBlock(lines, i)
+			blocks = append(blocks, node)
+
+		case headingPattern.MatchString(trimmed):
+			match := headingPattern.FindStringSubmatch(trimmed)
+			blocks = append(blocks, &Node{
+				Type:    TypeHeading,
+				Attrs:   map[string]interface{}{"level": len(match[1])},
+				Content: parseInline(match[2], nil),
+			})
+			i++
+
+		case rulePattern.MatchString(trimmed):
+			blocks = append(blocks, &Node{Type: TypeRule})
+			i++
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quoted []string
+			for ; i < len(lines); i++ {
+				line := strings.TrimSpace(lines[i])
+				if !strings.HasPrefix(line, ">") {
+					break
+				}
+				line = strings.TrimPrefix(line, ">")
+				quoted = append(quoted, strings.TrimPrefix(line, " "))
+			}
+			blocks = append(blocks, &Node{Type: TypeBlockquote, Content: nonEmpty(parseBlocks(quoted))})
+
+		case isTableStart(lines, i):
+			var node *Node
+			node, i = parseTable(lines, i)
+			blocks = append(blocks, node)
+
+		case parseListMarker(lines[i]) != nil:
+			var node *Node
+			node, i = parseList(lines, i)
+			blocks = append(blocks, node)
+
+		default:
+			var node *Node
+			node, i = parseParagraph(lines, i)
+			blocks = append(blocks, node)
+		}
+	}
+
+	return blocks
+}
+
+// parseParagraph collects lines up to the next blank line or block start;
+// line breaks inside a paragraph become hard breaks
+func parseParagraph(lines []string, i int) (*Node, int) {
+	paragraph := &Node{Type: TypeParagraph}
+
+	for start := i; i < len(lines); i++ {
+		if i > start && startsBlock(lines, i) {
+			break
+		}
+
+		line := strings.TrimSpace(lines[i])
+		line = strings.TrimSuffix(line, "\\")
+		if i > start {
+			paragraph.Content = append(paragraph.Content, &Node{Type: TypeHardBreak})
+		}
+		paragraph.Content = append(paragraph.Content, parseInline(line, nil)...)
+	}
+
+	return paragraph, i
+}
+
+// startsBlock reports whether lines[i] ends a paragraph
+func startsBlock(lines []string, i int) bool {
+	trimmed := strings.TrimSpace(lines[i])
+	return trimmed == "" ||
+		isFence(trimmed) ||
+		headingPattern.MatchString(trimmed) ||
+		rulePattern.MatchString(trimmed) ||
+		strings.HasPrefix(trimmed, ">") ||
+		isTableStart(lines, i) ||
+		parseListMarker(lines[i]) != nil
+}
+
+// isFence reports whether a line opens or closes a fenced code block
+func isFence(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+// parseCodeBlock parses a fenced code block starting at lines[i]
+func parseCodeBlock(lines []string, i int) (*Node, int) {
+	opening := strings.TrimSpace(lines[i])
+	fence := opening[:3]
+	language := strings.TrimSpace(strings.TrimLeft(opening, fence[:1]))
+
+	var code []string
+	for i++; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+			i++
+			break
+		}
+		code = append(code, lines[i])
+	}
+
+	node := &Node{Type: TypeCodeBlock}
+	if language != "" {
+		node.Attrs = map[string]interface{}{"language": language}
+	}
+	if text := strings.Join(code, "\n"); text != "" {
+		node.Content = []*Node{{Type: TypeText, Text: text}}
+	}
+	return node, i
+}
+
+// listMarker describes the marker of a list item line
+type listMarker struct {
+	indent        int    // Spaces before the marker
+	contentIndent int    // Spaces before the item content
+	ordered       bool   // Numbered rather than bulleted
+	number        int    // Item number for ordered lists
+	content       string // Text after the marker
+}
+
+// parseListMarker parses a list item line, returning nil if line is not one
+func parseListMarker(line string) *listMarker {
+	match := listMarkerPattern.FindStringSubmatch(line)
+	if match == nil {
+		return nil
+	}
+	// "1." alone is an empty item, but "-" alone would be a setext underline
+	if match[3] == "" && match[4] == "" && !strings.ContainsAny(match[2], ".)") {
+		return nil
+	}
+
+	marker := &listMarker{
+		indent:        len(match[1]),
+		contentIndent: len(match[1]) + len(match[2]) + len(match[3]),
+		content:       match[4],
+	}
+	if n, err := strconv.Atoi(strings.TrimRight(match[2], ".)")); err == nil {
+		marker.ordered = true
+		marker.number = n
+	}
+	return marker
+}
+
+// parseList parses a bullet or ordered list starting at lines[i], including nested lists
+func parseList(lines []string, i int) (*Node, int) {
+	first := parseListMarker(lines[i])
+	list := &Node{Type: TypeBulletList}
+	if first.ordered {
+		list.Type = TypeOrderedList
+		if first.number != 1 {
+			list.Attrs = map[string]interface{}{"order": first.number}
+		}
+	}
+
+	for i < len(lines) {
+		// A blank line only continues the list if another sibling item follows
+		if strings.TrimSpace(lines[i]) == "" {
+			next := nextNonBlank(lines, i)
+			if next < 0 {
+				break
+			}
+			if m := parseListMarker(lines[next]); m == nil || m.indent != first.indent || m.ordered != first.ordered {
+				break
+			}
+			i = next
+		}
+
+		marker := parseListMarker(lines[i])
+		if marker == nil || marker.indent < first.indent || marker.ordered != first.ordered {
+			break
+		}
+
+		itemLines := []string{marker.content}
+		for i++; i < len(lines); i++ {
+			line := lines[i]
+			if strings.TrimSpace(line) == "" {
+				next := nextNonBlank(lines, i)
+				if next < 0 || indentOf(lines[next]) <= first.indent {
+					break
+				}
+				itemLines = append(itemLines, "")
+				continue
+			}
+
+			if indent := indentOf(line); indent > first.indent {
+				if indent > marker.contentIndent {
+					indent = marker.contentIndent
+				}
+				itemLines = append(itemLines, line[indent:])
+				continue
+			}
+
+			// Lazy continuation of the item's paragraph
+			if !startsBlock(lines, i) {
+				itemLines = append(itemLines, strings.TrimSpace(line))
+				continue
+			}
+			break
+		}
+
+		list.Content = append(list.Content, &Node{
+			Type:    TypeListItem,
+			Content: nonEmpty(parseBlocks(itemLines)),
+		})
+	}
+
+	return list, i
+}
+
+// isTableStart reports whether lines[i] is a table header row followed by a separator row
+func isTableStart(lines []string, i int) bool {
+	if i+1 >= len(lines) || !strings.Contains(lines[i], "|") {
+		return false
+	}
+	separator := strings.TrimSpace(lines[i+1])
+	return strings.Contains(separator, "-") && tableSeparatorPattern.MatchString(separator)
+}
+
+// parseTable parses a GFM pipe table starting at lines[i]
+func parseTable(lines []string, i int) (*Node, int) {
+	table := &Node{Type: TypeTable}
+	table.Content = append(table.Content, parseTableRow(lines[i], TypeTableHeader))
+
+	for i += 2; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" || !strings.Contains(line, "|") {
+			break
+		}
+		table.Content = append(table.Content, parseTableRow(line, TypeTableCell))
+	}
+
+	return table, i
+}
+
+// parseTableRow parses one table row into cells of the given type
+func parseTableRow(line, cellType string) *Node {
+	row := &Node{Type: TypeTableRow}
+	for _, cell := range splitTableRow(line) {
+		row.Content = append(row.Content, &Node{
+			Type:    cellType,
+			Content: []*Node{{Type: TypeParagraph, Content: parseInline(cell, nil)}},
+		})
+	}
+	return row
+}
+
+// splitTableRow splits a table row on unescaped pipes
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, "\\|") {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// parseInline parses inline Markdown into text, mention and hard break nodes
+// carrying marks plus any emphasis, code and links found in s
+func parseInline(s string, marks []Mark) []*Node {
+	var nodes []*Node
+	var buf strings.Builder
+
+	flush := func() {
+		if buf.Len() > 0 {
+			nodes = appendText(nodes, buf.String(), marks)
+			buf.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			buf.WriteByte(s[i+1])
+			i += 2
+			continue
+
+		case c == '`':
+			ticks := len(s[i:]) - len(strings.TrimLeft(s[i:], "`"))
+			delim := s[i : i+ticks]
+			if end := strings.Index(s[i+ticks:], delim); end >= 0 {
+				flush()
+				code := s[i+ticks : i+ticks+end]
+				if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' {
+					code = code[1 : len(code)-1]
+				}
+				nodes = appendText(nodes, code, withMark(marks, Mark{Type: MarkCode}))
+				i += 2*ticks + end
+				continue
+			}
+			buf.WriteString(delim)
+			i += ticks
+			continue
+
+		case c == '@' && strings.HasPrefix(s[i:], "@["):
+			if text, dest, end, ok := parseLink(s, i+1); ok && strings.HasPrefix(dest, mentionScheme) {
+				flush()
+				nodes = append(nodes, &Node{
+					Type: TypeMention,
+					Attrs: map[string]interface{}{
+						"id":   strings.TrimPrefix(dest, mentionScheme),
+						"text": "@" + strings.TrimPrefix(text, "@"),
+					},
+				})
+				i = end
+				continue
+			}
+
+		case c == '[':
+			if text, dest, end, ok := parseLink(s, i); ok {
+				flush()
+				link := Mark{Type: MarkLink, Attrs: map[string]interface{}{"href": dest}}
+				nodes = append(nodes, parseInline(text, withMark(marks, link))...)
+				i = end
+				continue
+			}
+
+		case c == '<':
+			if end := strings.IndexByte(s[i:], '>'); end > 0 {
+				url := s[i+1 : i+end]
+				if (strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://")) && !strings.ContainsAny(url, " <") {
+					flush()
+					link := Mark{Type: MarkLink, Attrs: map[string]interface{}{"href": url}}
+					nodes = appendText(nodes, url, withMark(marks, link))
+					i += end + 1
+					continue
+				}
+			}
+
+		case c == '*' || c == '_' || c == '~':
+			if delim, markType := emphasisDelimiter(s, i); delim != "" {
+				if end := findClosing(s, i+len(delim), delim); end >= 0 {
+					flush()
+					inner := s[i+len(delim) : end]
+					nodes = append(nodes, parseInline(inner, withMark(marks, Mark{Type: markType}))...)
+					i = end + len(delim)
+					continue
+				}
+			}
+		}
+
+		buf.WriteByte(c)
+		i++
+	}
+
+	flush()
+	return nodes
+}
+
+// emphasisDelimiter returns the emphasis delimiter opening at s[i] and its mark type
+func emphasisDelimiter(s string, i int) (string, string) {
+	var delim, markType string
+	switch {
+	case strings.HasPrefix(s[i:], "**"), strings.HasPrefix(s[i:], "__"):
+		delim, markType = s[i:i+2], MarkStrong
+	case strings.HasPrefix(s[i:], "~~"):
+		delim, markType = "~~", MarkStrike
+	case s[i] == '*' || s[i] == '_':
+		delim, markType = s[i:i+1], MarkEm
+	default:
+		return "", ""
+	}
+
+	// Opening delimiters must be followed by text, and emphasis markers inside
+	// words (snake_case, 2*3*4) are literal
+	after := i + len(delim)
+	if after >= len(s) || s[after] == ' ' {
+		return "", ""
+	}
+	if delim[0] != '~' && i > 0 && isAlnum(s[i-1]) {
+		return "", ""
+	}
+	return delim, markType
+}
+
+// findClosing returns the index of the delimiter closing emphasis opened before start
+func findClosing(s string, start int, delim string) int {
+	for j := start + 1; j <= len(s)-len(delim); j++ {
+		switch {
+		case s[j] == '\\':
+			j++
+		case s[j] == '`':
+			if end := strings.IndexByte(s[j+1:], '`'); end >= 0 {
+				j += end + 1
+			}
+		case strings.HasPrefix(s[j:], delim) && s[j-1] != ' ':
+			next := j + len(delim)
+			// A single delimiter must not match half of a double one
+			if len(delim) == 1 && next < len(s) && s[next] == delim[0] {
+				j++
+				continue
+			}
+			if delim[0] != '~' && next < len(s) && isAlnum(s[next]) {
+				continue
+			}
+			return j
+		}
+	}
+	return -1
+}
+
+// parseLink parses [text](destination) starting at s[i] == '['
+func parseLink(s string, i int) (text, dest string, end int, ok bool) {
+	depth := 0
+	closeBracket := -1
+	for j := i; j < len(s) && closeBracket < 0; j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closeBracket = j
+			}
+		}
+	}
+	if closeBracket < 0 || closeBracket+1 >= len(s) || s[closeBracket+1] != '(' {
+		return "", "", 0, false
+	}
+
+	closeParen := strings.IndexByte(s[closeBracket+2:], ')')
+	if closeParen < 0 {
+		return "", "", 0, false
+	}
+
+	dest = strings.TrimSpace(s[closeBracket+2 : closeBracket+2+closeParen])
+	if space := strings.IndexAny(dest, " \t"); space >= 0 {
+		dest = dest[:space] // Drop an optional link title
+	}
+	return s[i+1 : closeBracket], strings.Trim(dest, "<>"), closeBracket + 3 + closeParen, true
+}
+
+// appendText appends text with marks, merging it into the previous node when the marks match
+func appendText(nodes []*Node, text string, marks []Mark) []*Node {
+	if text == "" {
+		return nodes
+	}
+	if n := len(nodes); n > 0 && nodes[n-1].Type == TypeText && sameMarks(nodes[n-1].Marks, marks) {
+		nodes[n-1].Text += text
+		return nodes
+	}
+	return append(nodes, &Node{Type: TypeText, Text: text, Marks: marks})
+}
+
+// withMark returns a copy of marks with mark added
+func withMark(marks []Mark, mark Mark) []Mark {
+	result := make([]Mark, 0, len(marks)+1)
+	result = append(result, marks...)
+	return append(result, mark)
+}
+
+// nonEmpty ensures container nodes have at least one block, as ADF requires
+func nonEmpty(blocks []*Node) []*Node {
+	if len(blocks) == 0 {
+		return []*Node{{Type: TypeParagraph}}
+	}
+	return blocks
+}
+
+// nextNonBlank returns the index of the next non-blank line after i, or -1
+func nextNonBlank(lines []string, i int) int {
+	for j := i + 1; j < len(lines); j++ {
+		if strings.TrimSpace(lines[j]) != "" {
+			return j
+		}
+	}
+	return -1
+}
+
+// indentOf returns the number of leading spaces in line
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// expandLeadingTabs replaces leading tabs with four spaces each
+func expandLeadingTabs(line string) string {
+	indent := len(line) - len(strings.TrimLeft(line, " \t"))
+	if !strings.Contains(line[:indent], "\t") {
+		return line
+	}
+	return strings.ReplaceAll(line[:indent], "\t", "    ") + line[indent:]
+}
+
+// isAlnum reports whether c is an ASCII letter or digit
+func isAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// isPunct reports whether c is ASCII punctuation that may be backslash-escaped
+func isPunct(c byte) bool {
+	return c > ' ' && c < 0x7f && !isAlnum(c)
+}
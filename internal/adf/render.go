@@ -0,0 +1,304 @@
+package adf
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ToMarkdown converts an ADF document (or any ADF node) to Markdown
+// Node types without a Markdown equivalent are reduced to their text content
+func ToMarkdown(node *Node) string {
+	if node == nil {
+		return ""
+	}
+	if node.Type == TypeDoc {
+		return renderBlocks(node.Content, "\n\n")
+	}
+	return renderBlocks([]*Node{node}, "\n\n")
+}
+
+// renderBlocks renders block nodes joined by sep, grouping stray inline nodes into paragraphs
+func renderBlocks(nodes []*Node, sep string) string {
+	var parts []string
+	var inline []*Node
+
+	flushInline := func() {
+		if len(inline) > 0 {
+			parts = append(parts, renderInline(inline))
+			inline = nil
+		}
+	}
+
+	for _, node := range nodes {
+		if IsInline(node.Type) {
+			inline = append(inline, node)
+			continue
+		}
+		flushInline()
+		if block := renderBlock(node); block != "" {
+			parts = append(parts, block)
+		}
+	}
+	flushInline()
+
+	return strings.Join(parts, sep)
+}
+
+// renderBlock renders a single block node
+func renderBlock(node *Node) string {
+	switch node.Type {
+	case TypeParagraph:
+		return renderInline(node.Content)
+
+	case TypeHeading:
+		level := node.AttrInt("level", 1)
+		if level < 1 || level > 6 {
+			level = 1
+		}
+		return strings.Repeat("#", level) + " " + renderInline(node.Content)
+
+	case TypeBulletList, TypeOrderedList, TypeTaskList:
+		return renderList(node)
+
+	case TypeCodeBlock:
+		code := plainText(node)
+		fence := "```"
+		for strings.Contains(code, fence) {
+			fence += "`"
+		}
+		return fence + node.AttrString("language") + "\n" + code + "\n" + fence
+
+	case TypeBlockquote:
+		return prefixLines(renderBlocks(node.Content, "\n\n"), "> ", ">")
+
+	case TypeRule:
+		return "---"
+
+	case TypeTable:
+		return renderTable(node)
+
+	default:
+		// Panels, expands, layouts and other containers: keep their content
+		if len(node.Content) > 0 {
+			return renderBlocks(node.Content, "\n\n")
+		}
+		return escapeText(node.Text)
+	}
+}
+
+// renderList renders a bullet, ordered or task list
+func renderList(list *Node) string {
+	number := list.AttrInt("order", 1)
+	var items []string
+
+	for _, item := range list.Content {
+		var marker string
+		switch {
+		case list.Type == TypeOrderedList:
+			marker = fmt.Sprintf("%d. ", number)
+			number++
+		case item.Type == TypeTaskItem && item.AttrString("state") == "DONE":
+			marker = "- [x] "
+		case item.Type == TypeTaskItem:
+			marker = "- [ ] "
+		default:
+			marker = "- "
+		}
+
+		// Continuation lines line up with the item text
+		lines := strings.SplitN(renderBlocks(item.Content, "\n"), "\n", 2)
+		text := marker + lines[0]
+		if len(lines) > 1 {
+			text += "\n" + prefixLines(lines[1], strings.Repeat(" ", len(marker)), "")
+		}
+		items = append(items, text)
+	}
+
+	return strings.Join(items, "\n")
+}
+
+// renderTable renders a table as a GFM pipe table; the first row is always the header
+func renderTable(table *Node) string {
+	var rows [][]string
+	columns := 0
+	for _, row := range table.Content {
+		var cells []string
+		for _, cell := range row.Content {
+			text := strings.ReplaceAll(renderBlocks(cell.Content, " "), "\n", " ")
+			cells = append(cells, strings.ReplaceAll(text, "|", "\\|"))
+		}
+		if len(cells) > columns {
+			columns = len(cells)
+		}
+		rows = append(rows, cells)
+	}
+	if len(rows) == 0 {
+		return ""
+	}
+
+	formatRow := func(cells []string) string {
+		for len(cells) < columns {
+			cells = append(cells, "")
+		}
+		return "| " + strings.Join(cells, " | ") + " |"
+	}
+
+	separator := make([]string, columns)
+	for i := range separator {
+		separator[i] = "---"
+	}
+	lines := []string{formatRow(rows[0]), formatRow(separator)}
+	for _, row := range rows[1:] {
+		lines = append(lines, formatRow(row))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderInline renders inline nodes to Markdown
+func renderInline(nodes []*Node) string {
+	var sb strings.Builder
+
+	for _, node := range nodes {
+		switch node.Type {
+		case TypeText:
+			sb.WriteString(applyMarks(node.Text, node.Marks))
+
+		case TypeHardBreak:
+			sb.WriteString("\n")
+
+		case TypeMention:
+			name := strings.TrimPrefix(node.AttrString("text"), "@")
+			if id := node.AttrString("id"); id != "" {
+				sb.WriteString("@[" + escapeText(name) + "](" + mentionScheme + id + ")")
+			} else {
+				sb.WriteString("@" + name)
+			}
+
+		case TypeEmoji:
+			if text := node.AttrString("text"); text != "" {
+				sb.WriteString(text)
+			} else {
+				sb.WriteString(node.AttrString("shortName"))
+			}
+
+		case TypeInlineCard:
+			sb.WriteString("<" + node.AttrString("url") + ">")
+
+		case TypeStatus:
+			sb.WriteString(node.AttrString("text"))
+
+		case TypeDate:
+			var ms int64
+			fmt.Sscan(node.AttrString("timestamp"), &ms)
+			sb.WriteString(time.UnixMilli(ms).UTC().Format("2006-01-02"))
+
+		default:
+			if len(node.Content) > 0 {
+				sb.WriteString(renderInline(node.Content))
+			} else {
+				sb.WriteString(escapeText(node.Text))
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// applyMarks wraps text in the Markdown syntax for its marks
+func applyMarks(text string, marks []Mark) string {
+	var code bool
+	var href string
+	var em, strong, strike bool
+	for _, mark := range marks {
+		switch mark.Type {
+		case MarkCode:
+			code = true
+		case MarkEm:
+			em = true
+		case MarkStrong:
+			strong = true
+		case MarkStrike:
+			strike = true
+		case MarkLink:
+			href, _ = mark.Attrs["href"].(string)
+		}
+	}
+
+	if code {
+		ticks := "`"
+		for strings.Contains(text, ticks) {
+			ticks += "`"
+		}
+		text = ticks + text + ticks
+	} else {
+		text = escapeText(text)
+	}
+
+	// Emphasis markers must hug the text, so keep surrounding spaces outside
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	leading := text[:strings.Index(text, trimmed)]
+	trailing := text[len(leading)+len(trimmed):]
+
+	if em {
+		trimmed = "*" + trimmed + "*"
+	}
+	if strong {
+		trimmed = "**" + trimmed + "**"
+	}
+	if strike {
+		trimmed = "~~" + trimmed + "~~"
+	}
+	if href != "" {
+		trimmed = "[" + trimmed + "](" + href + ")"
+	}
+
+	return leading + trimmed + trailing
+}
+
+// escapeText escapes characters that would otherwise be read as Markdown syntax
+func escapeText(text string) string {
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == '\\' || c == '`' || c == '*' || c == '[' || c == ']':
+			sb.WriteByte('\\')
+		case c == '_' && (i == 0 || !isAlnum(text[i-1])):
+			sb.WriteByte('\\')
+		case c == '~' && i+1 < len(text) && text[i+1] == '~':
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// plainText returns the concatenated text of a node and its descendants
+func plainText(node *Node) string {
+	if node.Type == TypeText {
+		return node.Text
+	}
+	var sb strings.Builder
+	for _, child := range node.Content {
+		sb.WriteString(plainText(child))
+	}
+	return sb.String()
+}
+
+// prefixLines prefixes every line of text, using blankPrefix for empty lines
+func prefixLines(text, prefix, blankPrefix string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = blankPrefix
+		} else {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
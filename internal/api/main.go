@@ -8,6 +8,7 @@ package main
 import "C"
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -79,15 +80,18 @@ func CreateTicketJSON(urlC *C.char, emailC *C.char, tokenC *C.char, projectC *C.
 	}
 
 	if req.Assignee != "" {
-		fields.Assignee = &jira.User{EmailAddress: req.Assignee}
-	}
-
-	request := jira.CreateIssueRequest{
-		Fields: fields,
+		assignee, err := jira.NewUserService(client).ResolveAssignee(context.Background(), req.Assignee, project)
+		if err != nil {
+			resp := CreateTicketResponse{Error: err.Error()}
+			data, _ := json.Marshal(resp)
+			return C.CString(string(data))
+		}
+		fields.Assignee = assignee
 	}
 
-	var response jira.CreateIssueResponse
-	if err := client.Do("POST", "/rest/api/2/issue", request, &response); err != nil {
+	issueService := jira.NewIssueService(client)
+	response, err := issueService.CreateIssueWithFields(fields)
+	if err != nil {
 		resp := CreateTicketResponse{Error: err.Error()}
 		data, _ := json.Marshal(resp)
 		return C.CString(string(data))
@@ -164,15 +168,10 @@ func SearchTickets(urlC *C.char, emailC *C.char, tokenC *C.char, jqlC *C.char) *
 		Token:   token,
 	}
 
-	result, err := client.GetIssueByJQL(jql, 0, 50)
-	if err != nil {
-		resp := map[string]interface{}{"error": err.Error()}
-		data, _ := json.Marshal(resp)
-		return C.CString(string(data))
-	}
-
-	tickets := make([]map[string]interface{}, 0, len(result.Issues))
-	for _, issue := range result.Issues {
+	tickets := make([]map[string]interface{}, 0)
+	it := client.Search(context.Background(), jql, jira.SearchOptions{})
+	for it.Next() {
+		issue := it.Issue()
 		priority := ""
 		if issue.Fields.Priority != nil {
 			priority = issue.Fields.Priority.Name
@@ -195,9 +194,21 @@ func SearchTickets(urlC *C.char, emailC *C.char, tokenC *C.char, jqlC *C.char) *
 		}
 		tickets = append(tickets, ticket)
 	}
+	if err := it.Err(); err != nil {
+		resp := map[string]interface{}{"error": err.Error()}
+		data, _ := json.Marshal(resp)
+		return C.CString(string(data))
+	}
+
+	// Every page is fetched, so the tickets are all the matches; servers using
+	// token pagination (API v3) report no total of their own
+	total := it.Total()
+	if total < 0 {
+		total = len(tickets)
+	}
 
 	resp := map[string]interface{}{
-		"total":   result.Total,
+		"total":   total,
 		"count":   len(tickets),
 		"tickets": tickets,
 	}
@@ -240,7 +251,14 @@ func UpdateTicket(urlC *C.char, emailC *C.char, tokenC *C.char, keyC *C.char, js
 		fields.Priority = &jira.Priority{Name: priority}
 	}
 	if assignee, ok := updateReq["assignee"].(string); ok && assignee != "" {
-		fields.Assignee = &jira.User{EmailAddress: assignee}
+		projectKey, _ := jira.ExtractProjectKey(key)
+		user, err := jira.NewUserService(client).ResolveAssignee(context.Background(), assignee, projectKey)
+		if err != nil {
+			resp := map[string]interface{}{"error": err.Error()}
+			data, _ := json.Marshal(resp)
+			return C.CString(string(data))
+		}
+		fields.Assignee = user
 	}
 
 	// Perform update using client's Do method directly
@@ -4,23 +4,48 @@ import (
 	"encoding/csv"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
 // TicketData represents a single ticket to create
 type TicketData struct {
-	Summary     string
-	Description string
-	IssueType   string
-	Priority    string
-	Assignee    string
-	Labels      []string
-	Components  []string
-	BlockedBy   []string
+	Summary         string
+	Description     string
+	IssueType       string
+	Priority        string
+	Assignee        string
+	Labels          []string
+	Components      []string
+	BlockedBy       []string
+	ParentKey       string   // Parent epic, or parent issue of a sub-task
+	FixVersions     []string // Version names
+	AffectsVersions []string // Affected version names
+	Attachments     []string // File paths; relative paths are resolved against the input file's directory
+
+	// Fields holds values for any other columns, keyed by field name or ID (e.g. "Story Points")
+	Fields map[string]string
+}
+
+// knownColumns are the columns mapped to TicketData's named fields; any other column is a field
+var knownColumns = map[string]bool{
+	"summary":          true,
+	"description":      true,
+	"issue_type":       true,
+	"priority":         true,
+	"assignee":         true,
+	"labels":           true,
+	"components":       true,
+	"blocked_by":       true,
+	"parent_key":       true,
+	"fix_versions":     true,
+	"affects_versions": true,
+	"attachments":      true,
 }
 
 // ParseCSVFile parses a CSV file and returns ticket data
-// Expected columns: summary,description,issue_type,priority,assignee,labels,components,blocked_by
+// Expected columns: summary,description,issue_type,priority,assignee,labels,components,blocked_by,parent_key,fix_versions,affects_versions,attachments
+// Any other column (e.g. "Story Points" or customfield_10011) sets the field of that name or ID
 func ParseCSVFile(filepath string) ([]TicketData, error) {
 	file, err := os.Open(filepath)
 	if err != nil {
@@ -116,6 +141,39 @@ func ParseCSVFile(filepath string) ([]TicketData, error) {
 			}
 		}
 
+		if idx, ok := columnMap["parent_key"]; ok && idx < len(record) {
+			ticket.ParentKey = strings.TrimSpace(record[idx])
+		}
+
+		if idx, ok := columnMap["fix_versions"]; ok && idx < len(record) {
+			ticket.FixVersions = splitList(record[idx])
+		}
+
+		if idx, ok := columnMap["affects_versions"]; ok && idx < len(record) {
+			ticket.AffectsVersions = splitList(record[idx])
+		}
+
+		if idx, ok := columnMap["attachments"]; ok && idx < len(record) {
+			attachments := strings.TrimSpace(record[idx])
+			if attachments != "" {
+				ticket.Attachments = resolvePaths(filepath, strings.Split(attachments, ","))
+			}
+		}
+
+		// Remaining columns are fields; empty cells are left unset
+		for idx, col := range header {
+			name := strings.TrimSpace(col)
+			if knownColumns[strings.ToLower(name)] || name == "" || idx >= len(record) {
+				continue
+			}
+			if value := strings.TrimSpace(record[idx]); value != "" {
+				if ticket.Fields == nil {
+					ticket.Fields = make(map[string]string)
+				}
+				ticket.Fields[name] = value
+			}
+		}
+
 		// Validate
 		if ticket.Summary == "" {
 			return nil, fmt.Errorf("row %d: summary is required", i+2)
@@ -126,3 +184,31 @@ func ParseCSVFile(filepath string) ([]TicketData, error) {
 
 	return tickets, nil
 }
+
+// splitList splits a comma-separated cell, trimming spaces and dropping empty entries
+func splitList(cell string) []string {
+	var values []string
+	for _, value := range strings.Split(cell, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// resolvePaths trims paths and resolves relative ones against the directory of inputFile
+func resolvePaths(inputFile string, paths []string) []string {
+	dir := filepath.Dir(inputFile)
+	var resolved []string
+	for _, p := range paths {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		resolved = append(resolved, p)
+	}
+	return resolved
+}
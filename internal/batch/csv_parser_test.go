@@ -2,6 +2,8 @@ package batch
 
 import (
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -150,3 +152,87 @@ func TestParseCSVFileWithLabelsAndComponents(t *testing.T) {
 		t.Errorf("Expected 2 components, got %d", len(tickets[0].Components))
 	}
 }
+
+func TestParseCSVFileWithAttachments(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "tickets.csv")
+	csvContent := `summary,attachments
+"Task 1","logs/crash.log, /tmp/screen.png"
+"Task 2",""`
+
+	if err := os.WriteFile(csvPath, []byte(csvContent), 0644); err != nil {
+		t.Fatalf("Failed to write CSV file: %v", err)
+	}
+
+	tickets, err := ParseCSVFile(csvPath)
+	if err != nil {
+		t.Fatalf("ParseCSVFile() error = %v", err)
+	}
+
+	expected := []string{filepath.Join(dir, "logs", "crash.log"), "/tmp/screen.png"}
+	if len(tickets[0].Attachments) != 2 || tickets[0].Attachments[0] != expected[0] || tickets[0].Attachments[1] != expected[1] {
+		t.Errorf("Attachments = %v, want %v", tickets[0].Attachments, expected)
+	}
+
+	if len(tickets[1].Attachments) != 0 {
+		t.Errorf("Expected no attachments, got %v", tickets[1].Attachments)
+	}
+}
+
+func TestParseCSVFileWithFieldColumns(t *testing.T) {
+	csvPath := filepath.Join(t.TempDir(), "tickets.csv")
+	csvContent := `summary,Priority,parent_key,Story Points,customfield_10011
+"Task 1",High,PROJ-1,5,PROJ-9
+"Task 2",,,,`
+
+	if err := os.WriteFile(csvPath, []byte(csvContent), 0644); err != nil {
+		t.Fatalf("Failed to write CSV file: %v", err)
+	}
+
+	tickets, err := ParseCSVFile(csvPath)
+	if err != nil {
+		t.Fatalf("ParseCSVFile() error = %v", err)
+	}
+
+	if tickets[0].Priority != "High" {
+		t.Errorf("Priority = %q, want High", tickets[0].Priority)
+	}
+	if tickets[0].Fields["Story Points"] != "5" || tickets[0].Fields["customfield_10011"] != "PROJ-9" {
+		t.Errorf("Fields = %v", tickets[0].Fields)
+	}
+	if tickets[0].ParentKey != "PROJ-1" {
+		t.Errorf("ParentKey = %q, want PROJ-1", tickets[0].ParentKey)
+	}
+	if _, ok := tickets[0].Fields["Priority"]; ok {
+		t.Error("known column should not be treated as a field")
+	}
+	if len(tickets[1].Fields) != 0 {
+		t.Errorf("empty cells should be skipped, got %v", tickets[1].Fields)
+	}
+}
+
+func TestParseCSVFileWithVersionColumns(t *testing.T) {
+	csvPath := filepath.Join(t.TempDir(), "tickets.csv")
+	csvContent := `summary,fix_versions,affects_versions
+"Bug 1","2.4.0, 2.4.1",2.3.0
+"Bug 2",,`
+
+	if err := os.WriteFile(csvPath, []byte(csvContent), 0644); err != nil {
+		t.Fatalf("Failed to write CSV file: %v", err)
+	}
+
+	tickets, err := ParseCSVFile(csvPath)
+	if err != nil {
+		t.Fatalf("ParseCSVFile() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(tickets[0].FixVersions, []string{"2.4.0", "2.4.1"}) {
+		t.Errorf("FixVersions = %v", tickets[0].FixVersions)
+	}
+	if !reflect.DeepEqual(tickets[0].AffectsVersions, []string{"2.3.0"}) {
+		t.Errorf("AffectsVersions = %v", tickets[0].AffectsVersions)
+	}
+	if tickets[1].FixVersions != nil || len(tickets[0].Fields) != 0 {
+		t.Errorf("FixVersions = %v, Fields = %v", tickets[1].FixVersions, tickets[0].Fields)
+	}
+}
@@ -0,0 +1,114 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+)
+
+// DefaultMaxConcurrent is the default number of issues changed at the same time
+const DefaultMaxConcurrent = 5
+
+// IssueResult represents the result of changing a single existing issue
+type IssueResult struct {
+	Index   int
+	Key     string
+	Summary string
+	Detail  string // Short description of what was done, e.g. the new status
+	Error   error
+	Status  string // "done" or "failed"
+	Retries int    // Retried requests while changing this issue
+}
+
+// IssueFunc changes one issue, returning a short description of what it did
+type IssueFunc func(ctx context.Context, issue jira.Issue) (string, error)
+
+// ApplyToIssues calls fn for every issue, at most maxConcurrent at a time
+// Issues not yet started when ctx is cancelled are reported as failed
+func ApplyToIssues(ctx context.Context, issues []jira.Issue, maxConcurrent int, fn IssueFunc) []IssueResult {
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+
+	results := make([]IssueResult, 0, len(issues))
+	var resultsMutex sync.Mutex
+
+	// Use a semaphore for concurrency control
+	semaphore := make(chan struct{}, maxConcurrent)
+	var wg sync.WaitGroup
+
+	for i, issue := range issues {
+		wg.Add(1)
+		go func(index int, issue jira.Issue) {
+			defer wg.Done()
+
+			result := IssueResult{
+				Index:   index,
+				Key:     issue.Key,
+				Summary: issue.Fields.Summary,
+			}
+
+			select {
+			case semaphore <- struct{}{}: // Acquire
+				var stats jira.RequestStats
+				result.Detail, result.Error = fn(jira.WithRequestStats(ctx, &stats), issue)
+				result.Retries = stats.Retries()
+				<-semaphore // Release
+			case <-ctx.Done():
+				result.Error = ctx.Err()
+			}
+
+			result.Status = "done"
+			if result.Error != nil {
+				result.Status = "failed"
+			}
+
+			resultsMutex.Lock()
+			results = append(results, result)
+			resultsMutex.Unlock()
+		}(i, issue)
+	}
+
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+	return results
+}
+
+// PrintIssueResults prints the results of changing existing issues
+func PrintIssueResults(results []IssueResult) {
+	successCount := 0
+	failureCount := 0
+
+	fmt.Println("\n📊 Bulk Results:")
+	fmt.Println("=====================================")
+
+	for _, result := range results {
+		switch result.Status {
+		case "done":
+			successCount++
+			fmt.Printf("✅ %s %s%s%s\n", result.Key, result.Summary, formatDetail(result.Detail), FormatRetries(result.Retries))
+		case "failed":
+			failureCount++
+			fmt.Printf("❌ %s %s: %v%s\n", result.Key, result.Summary, result.Error, FormatRetries(result.Retries))
+		}
+	}
+
+	fmt.Println("=====================================")
+	fmt.Printf("Success: %d | Failures: %d\n", successCount, failureCount)
+
+	if failureCount == 0 {
+		fmt.Println("🎉 All tickets processed successfully!")
+	}
+}
+
+// formatDetail returns a detail annotation, or "" when there is none
+func formatDetail(detail string) string {
+	if detail == "" {
+		return ""
+	}
+	return " -> " + detail
+}
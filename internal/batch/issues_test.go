@@ -0,0 +1,76 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+)
+
+func TestApplyToIssues(t *testing.T) {
+	var issues []jira.Issue
+	for i := 1; i <= 10; i++ {
+		issues = append(issues, jira.Issue{Key: fmt.Sprintf("PROJ-%d", i)})
+	}
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	results := ApplyToIssues(context.Background(), issues, 3, func(ctx context.Context, issue jira.Issue) (string, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		if issue.Key == "PROJ-4" {
+			return "", errors.New("transition not available")
+		}
+		return "Done", nil
+	})
+
+	if maxRunning > 3 {
+		t.Errorf("ran %d issues at once, want at most 3", maxRunning)
+	}
+	if len(results) != len(issues) {
+		t.Fatalf("got %d results, want %d", len(results), len(issues))
+	}
+	for i, result := range results {
+		if result.Key != issues[i].Key {
+			t.Errorf("results[%d].Key = %s, want %s", i, result.Key, issues[i].Key)
+		}
+		wantStatus := "done"
+		if result.Key == "PROJ-4" {
+			wantStatus = "failed"
+		}
+		if result.Status != wantStatus {
+			t.Errorf("%s status = %s, want %s", result.Key, result.Status, wantStatus)
+		}
+	}
+}
+
+func TestApplyToIssuesCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	issues := []jira.Issue{{Key: "PROJ-1"}, {Key: "PROJ-2"}}
+	results := ApplyToIssues(ctx, issues, 1, func(ctx context.Context, issue jira.Issue) (string, error) {
+		return "", ctx.Err()
+	})
+
+	for _, result := range results {
+		if result.Status != "failed" || !errors.Is(result.Error, context.Canceled) {
+			t.Errorf("%s = %s (%v), want failed with context.Canceled", result.Key, result.Status, result.Error)
+		}
+	}
+}
@@ -4,22 +4,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 )
 
 // JSONTicketData represents ticket data in JSON format
 type JSONTicketData struct {
-	Summary     string   `json:"summary"`
-	Description string   `json:"description,omitempty"`
-	IssueType   string   `json:"issue_type,omitempty"`
-	Priority    string   `json:"priority,omitempty"`
-	Assignee    string   `json:"assignee,omitempty"`
-	Labels      []string `json:"labels,omitempty"`
-	Components  []string `json:"components,omitempty"`
-	BlockedBy   []string `json:"blocked_by,omitempty"`
+	Summary         string   `json:"summary"`
+	Description     string   `json:"description,omitempty"`
+	IssueType       string   `json:"issue_type,omitempty"`
+	Priority        string   `json:"priority,omitempty"`
+	Assignee        string   `json:"assignee,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+	Components      []string `json:"components,omitempty"`
+	BlockedBy       []string `json:"blocked_by,omitempty"`
+	ParentKey       string   `json:"parent_key,omitempty"`
+	FixVersions     []string `json:"fix_versions,omitempty"`
+	AffectsVersions []string `json:"affects_versions,omitempty"`
+	Attachments     []string `json:"attachments,omitempty"`
 }
 
 // ParseJSONFile parses a JSON file and returns ticket data
 // Expected format: array of ticket objects
+// Any other key (e.g. "Story Points" or "customfield_10011") sets the field of that name or ID
 func ParseJSONFile(filepath string) ([]TicketData, error) {
 	data, err := os.ReadFile(filepath)
 	if err != nil {
@@ -31,6 +37,12 @@ func ParseJSONFile(filepath string) ([]TicketData, error) {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
 
+	// Decode again generically to pick up keys that aren't named fields
+	var rawTickets []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawTickets); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
 	if len(jsonTickets) == 0 {
 		return nil, fmt.Errorf("JSON file contains no tickets")
 	}
@@ -43,15 +55,25 @@ func ParseJSONFile(filepath string) ([]TicketData, error) {
 		}
 
 		ticket := TicketData{
-			Summary:     jt.Summary,
-			Description: jt.Description,
-			IssueType:   jt.IssueType,
-			Priority:    jt.Priority,
-			Assignee:    jt.Assignee,
-			Labels:      jt.Labels,
-			Components:  jt.Components,
-			BlockedBy:   jt.BlockedBy,
+			Summary:         jt.Summary,
+			Description:     jt.Description,
+			IssueType:       jt.IssueType,
+			Priority:        jt.Priority,
+			Assignee:        jt.Assignee,
+			Labels:          jt.Labels,
+			Components:      jt.Components,
+			BlockedBy:       jt.BlockedBy,
+			ParentKey:       jt.ParentKey,
+			FixVersions:     jt.FixVersions,
+			AffectsVersions: jt.AffectsVersions,
+			Attachments:     resolvePaths(filepath, jt.Attachments),
+		}
+
+		fields, err := extraFields(rawTickets[i])
+		if err != nil {
+			return nil, fmt.Errorf("ticket %d: %w", i, err)
 		}
+		ticket.Fields = fields
 
 		// Set defaults
 		if ticket.IssueType == "" {
@@ -66,3 +88,58 @@ func ParseJSONFile(filepath string) ([]TicketData, error) {
 
 	return tickets, nil
 }
+
+// extraFields returns the keys of a ticket object that aren't named fields, as text
+// Arrays of scalars become comma-separated lists; objects and other arrays are kept as JSON
+func extraFields(object map[string]json.RawMessage) (map[string]string, error) {
+	var fields map[string]string
+	for key, raw := range object {
+		if knownColumns[strings.ToLower(key)] {
+			continue
+		}
+
+		value, err := fieldText(raw)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", key, err)
+		}
+		if value == "" {
+			continue
+		}
+		if fields == nil {
+			fields = make(map[string]string)
+		}
+		fields[key] = value
+	}
+	return fields, nil
+}
+
+// fieldText converts a JSON value to the text form used for field values
+func fieldText(raw json.RawMessage) (string, error) {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			switch item.(type) {
+			case string, float64, bool:
+				parts = append(parts, fmt.Sprint(item))
+			default:
+				return string(raw), nil
+			}
+		}
+		return strings.Join(parts, ","), nil
+	case map[string]interface{}:
+		return string(raw), nil
+	default:
+		// Numbers and booleans keep their JSON spelling
+		return strings.TrimSpace(string(raw)), nil
+	}
+}
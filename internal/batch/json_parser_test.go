@@ -2,6 +2,7 @@ package batch
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -169,3 +170,72 @@ func TestParseJSONFileWithArraysAndLabels(t *testing.T) {
 		t.Errorf("Expected 2 blocked_by items, got %d", len(tickets[0].BlockedBy))
 	}
 }
+
+func TestParseJSONFileWithFieldKeys(t *testing.T) {
+	jsonContent := `[
+  {
+    "summary": "Task 1",
+    "labels": ["a"],
+    "Story Points": 5,
+    "Team": "Payments",
+    "Fix Versions": ["1.0", "1.1"],
+    "customfield_10050": {"value": "Hardware"},
+    "Unset": null
+  }
+]`
+
+	tmpfile, err := os.CreateTemp("", "test*.json")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpfile.Name())
+
+	if _, err := tmpfile.WriteString(jsonContent); err != nil {
+		t.Fatalf("Failed to write to temp file: %v", err)
+	}
+	tmpfile.Close()
+
+	tickets, err := ParseJSONFile(tmpfile.Name())
+	if err != nil {
+		t.Fatalf("ParseJSONFile() error = %v", err)
+	}
+
+	expected := map[string]string{
+		"Story Points":      "5",
+		"Team":              "Payments",
+		"Fix Versions":      "1.0,1.1",
+		"customfield_10050": `{"value": "Hardware"}`,
+	}
+	fields := tickets[0].Fields
+	if len(fields) != len(expected) {
+		t.Errorf("Fields = %v, want %v", fields, expected)
+	}
+	for name, want := range expected {
+		if fields[name] != want {
+			t.Errorf("Fields[%q] = %q, want %q", name, fields[name], want)
+		}
+	}
+}
+
+func TestParseJSONFileWithVersions(t *testing.T) {
+	jsonPath := filepath.Join(t.TempDir(), "tickets.json")
+	jsonContent := `[{"summary": "Bug 1", "fix_versions": ["2.4.0"], "affects_versions": ["2.3.0", "2.3.1"]}]`
+	if err := os.WriteFile(jsonPath, []byte(jsonContent), 0644); err != nil {
+		t.Fatalf("Failed to write JSON file: %v", err)
+	}
+
+	tickets, err := ParseJSONFile(jsonPath)
+	if err != nil {
+		t.Fatalf("ParseJSONFile() error = %v", err)
+	}
+
+	if len(tickets[0].FixVersions) != 1 || tickets[0].FixVersions[0] != "2.4.0" {
+		t.Errorf("FixVersions = %v", tickets[0].FixVersions)
+	}
+	if len(tickets[0].AffectsVersions) != 2 {
+		t.Errorf("AffectsVersions = %v", tickets[0].AffectsVersions)
+	}
+	if len(tickets[0].Fields) != 0 {
+		t.Errorf("version keys should not be treated as fields, got %v", tickets[0].Fields)
+	}
+}
@@ -1,7 +1,10 @@
 package batch
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"sort"
 	"sync"
 
 	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
@@ -14,26 +17,42 @@ type ProcessResult struct {
 	CreatedKey string
 	Error      error
 	Status     string
+	Retries    int // Retried requests while processing this ticket
 }
 
 // BatchProcessor handles batch ticket operations
 type BatchProcessor struct {
-	client        *jira.Client
-	projectKey    string
-	maxConcurrent int
+	client         *jira.Client
+	fieldService   *jira.FieldService   // Shared so the field list is fetched once per batch
+	parentService  *jira.ParentService  // Shared so each parent's type is fetched once
+	versionService *jira.VersionService // Shared so the project's versions are fetched once
+	userService    *jira.UserService    // Shared so each assignee is looked up once
+	projectKey     string
+	maxConcurrent  int
+
+	// Bulk creates tickets through the bulk endpoint, jira.MaxBulkCreate per request,
+	// instead of one request per ticket
+	Bulk bool
 }
 
 // NewBatchProcessor creates a new batch processor
 func NewBatchProcessor(client *jira.Client, projectKey string) *BatchProcessor {
 	return &BatchProcessor{
-		client:        client,
-		projectKey:    projectKey,
-		maxConcurrent: 3, // Default concurrent operations
+		client:         client,
+		fieldService:   jira.NewFieldService(client),
+		parentService:  jira.NewParentService(client),
+		versionService: jira.NewVersionService(client),
+		userService:    jira.NewUserService(client),
+		projectKey:     projectKey,
+		maxConcurrent:  3, // Default concurrent operations
+		Bulk:           true,
 	}
 }
 
 // ValidateTickets validates all tickets before creation
-func (bp *BatchProcessor) ValidateTickets(tickets []TicketData, validator *jira.Validator) []ProcessResult {
+// Each ticket's fields are built as they would be sent and checked against the
+// project's create metadata; every problem with a ticket is reported together
+func (bp *BatchProcessor) ValidateTickets(ctx context.Context, tickets []TicketData, validator *jira.Validator) []ProcessResult {
 	var results []ProcessResult
 
 	for i, ticket := range tickets {
@@ -43,42 +62,39 @@ func (bp *BatchProcessor) ValidateTickets(tickets []TicketData, validator *jira.
 			Status:     "validated",
 		}
 
-		// Validate summary
-		if ticket.Summary == "" {
-			result.Error = fmt.Errorf("summary is required")
-			result.Status = "failed"
-			results = append(results, result)
-			continue
-		}
+		var problems jira.ValidationErrors
 
-		// Validate issue type
-		if err := validator.ValidateIssueType(ticket.IssueType); err != nil {
-			result.Error = err
-			result.Status = "failed"
-			results = append(results, result)
-			continue
-		}
+		// Validate attachments exist
+		problems.Add(validateAttachments(ticket.Attachments))
 
-		// Validate priority if set
-		if ticket.Priority != "" {
-			if err := validator.ValidatePriority(ticket.Priority); err != nil {
-				result.Error = err
-				result.Status = "failed"
-				results = append(results, result)
-				continue
+		// Validate the parent exists, since building the fields doesn't always fetch it;
+		// a missing parent is left out of the build so it is only reported once
+		build := ticket
+		if ticket.ParentKey != "" {
+			if err := validator.ValidateTicketsExistContext(ctx, []string{ticket.ParentKey}); err != nil {
+				problems.Add(fmt.Errorf("parent validation failed: %w", err))
+				build.ParentKey = ""
 			}
 		}
 
+		// Validate the assignee, versions, custom fields and parent resolve
+		fields, err := bp.buildFields(ctx, build)
+		problems.Add(err)
+
+		// Validate issue type, priority, required fields and allowed values
+		problems.Add(validator.ValidateIssue(ctx, fields))
+
 		// Validate blocked-by tickets exist
 		if len(ticket.BlockedBy) > 0 {
-			if err := validator.ValidateTicketsExist(ticket.BlockedBy); err != nil {
-				result.Error = fmt.Errorf("blocked-by validation failed: %w", err)
-				result.Status = "failed"
-				results = append(results, result)
-				continue
+			if err := validator.ValidateTicketsExistContext(ctx, ticket.BlockedBy); err != nil {
+				problems.Add(fmt.Errorf("blocked-by validation failed: %w", err))
 			}
 		}
 
+		if err := problems.Err(); err != nil {
+			result.Error = err
+			result.Status = "failed"
+		}
 		results = append(results, result)
 	}
 
@@ -86,7 +102,12 @@ func (bp *BatchProcessor) ValidateTickets(tickets []TicketData, validator *jira.
 }
 
 // CreateTickets creates all validated tickets
-func (bp *BatchProcessor) CreateTickets(tickets []TicketData) []ProcessResult {
+// Tickets not yet started when ctx is cancelled are reported as failed
+func (bp *BatchProcessor) CreateTickets(ctx context.Context, tickets []TicketData) []ProcessResult {
+	if bp.Bulk {
+		return bp.createTicketsBulk(ctx, tickets)
+	}
+
 	var results []ProcessResult
 	var resultsMutex sync.Mutex
 
@@ -99,10 +120,19 @@ func (bp *BatchProcessor) CreateTickets(tickets []TicketData) []ProcessResult {
 		go func(index int, t TicketData) {
 			defer wg.Done()
 
-			semaphore <- struct{}{}        // Acquire
-			defer func() { <-semaphore }() // Release
-
-			result := bp.createSingleTicket(index, t)
+			var result ProcessResult
+			select {
+			case semaphore <- struct{}{}: // Acquire
+				result = bp.createSingleTicket(ctx, index, t)
+				<-semaphore // Release
+			case <-ctx.Done():
+				result = ProcessResult{
+					Index:      index,
+					TicketData: t,
+					Error:      ctx.Err(),
+					Status:     "failed",
+				}
+			}
 
 			resultsMutex.Lock()
 			results = append(results, result)
@@ -114,8 +144,50 @@ func (bp *BatchProcessor) CreateTickets(tickets []TicketData) []ProcessResult {
 	return results
 }
 
+// createTicketsBulk creates tickets through the bulk endpoint
+// Tickets the server rejects are retried one at a time, so each failure maps back to its row
+func (bp *BatchProcessor) createTicketsBulk(ctx context.Context, tickets []TicketData) []ProcessResult {
+	results := make([]ProcessResult, 0, len(tickets))
+
+	var fields []jira.IssueFields
+	var indices []int
+	for i, ticket := range tickets {
+		f, err := bp.buildFields(ctx, ticket)
+		if err != nil {
+			results = append(results, ProcessResult{
+				Index:      i,
+				TicketData: ticket,
+				Error:      err,
+				Status:     "failed",
+			})
+			continue
+		}
+		fields = append(fields, f)
+		indices = append(indices, i)
+	}
+
+	for _, created := range jira.NewIssueService(bp.client).CreateIssuesBulk(ctx, fields) {
+		index := indices[created.Index]
+		result := ProcessResult{
+			Index:      index,
+			TicketData: tickets[index],
+		}
+		if created.Err != nil {
+			result.Error = created.Err
+			result.Status = "failed"
+		} else {
+			result.CreatedKey = created.Response.Key
+			result.Status = "created"
+		}
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+	return results
+}
+
 // LinkTickets creates links between tickets based on blocked-by relationships
-func (bp *BatchProcessor) LinkTickets(createResults []ProcessResult) []ProcessResult {
+func (bp *BatchProcessor) LinkTickets(ctx context.Context, createResults []ProcessResult) []ProcessResult {
 	linkService := jira.NewLinkService(bp.client)
 	var results []ProcessResult
 
@@ -126,7 +198,7 @@ func (bp *BatchProcessor) LinkTickets(createResults []ProcessResult) []ProcessRe
 
 		for _, blockerKey := range createResult.TicketData.BlockedBy {
 			// Create link: blockerKey blocks createdKey
-			if err := linkService.LinkBlocks(blockerKey, createResult.CreatedKey); err != nil {
+			if err := linkService.LinkBlocksContext(ctx, blockerKey, createResult.CreatedKey); err != nil {
 				results = append(results, ProcessResult{
 					Index:      createResult.Index,
 					TicketData: createResult.TicketData,
@@ -142,9 +214,82 @@ func (bp *BatchProcessor) LinkTickets(createResults []ProcessResult) []ProcessRe
 	return results
 }
 
-// createSingleTicket creates a single ticket
-func (bp *BatchProcessor) createSingleTicket(index int, ticket TicketData) ProcessResult {
-	issueService := jira.NewIssueService(bp.client)
+// AttachFiles uploads each created ticket's attachments
+// Returns a "partial" result for every ticket whose upload failed
+func (bp *BatchProcessor) AttachFiles(ctx context.Context, createResults []ProcessResult) []ProcessResult {
+	attachmentService := jira.NewAttachmentService(bp.client)
+	var results []ProcessResult
+
+	for _, createResult := range createResults {
+		if createResult.Error != nil || len(createResult.TicketData.Attachments) == 0 {
+			continue
+		}
+
+		if _, err := attachmentService.AddAttachmentFiles(ctx, createResult.CreatedKey, createResult.TicketData.Attachments...); err != nil {
+			results = append(results, ProcessResult{
+				Index:      createResult.Index,
+				TicketData: createResult.TicketData,
+				CreatedKey: createResult.CreatedKey,
+				Error:      err,
+				Status:     "partial", // Created but upload failed
+			})
+		}
+	}
+
+	return results
+}
+
+// AddToSprint moves every created ticket into a sprint
+// Returns a "partial" result for every ticket when the move fails
+func (bp *BatchProcessor) AddToSprint(ctx context.Context, sprintID int, createResults []ProcessResult) []ProcessResult {
+	var created []ProcessResult
+	var keys []string
+	for _, createResult := range createResults {
+		if createResult.Error == nil {
+			created = append(created, createResult)
+			keys = append(keys, createResult.CreatedKey)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	err := jira.NewAgileService(bp.client).MoveToSprint(ctx, sprintID, keys...)
+	if err == nil {
+		return nil
+	}
+
+	results := make([]ProcessResult, 0, len(created))
+	for _, createResult := range created {
+		results = append(results, ProcessResult{
+			Index:      createResult.Index,
+			TicketData: createResult.TicketData,
+			CreatedKey: createResult.CreatedKey,
+			Error:      err,
+			Status:     "partial", // Created but not added to the sprint
+		})
+	}
+	return results
+}
+
+// validateAttachments checks that every attachment file exists and is a regular file
+func validateAttachments(paths []string) error {
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("attachment not found: %s", path)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("attachment is a directory: %s", path)
+		}
+	}
+	return nil
+}
+
+// buildFields builds the fields sent to create a ticket, resolving its assignee,
+// versions, custom fields and parent; every field that fails to resolve is reported
+func (bp *BatchProcessor) buildFields(ctx context.Context, ticket TicketData) (jira.IssueFields, error) {
+	var problems jira.ValidationErrors
 
 	fields := jira.IssueFields{
 		Project: jira.Project{
@@ -165,9 +310,9 @@ func (bp *BatchProcessor) createSingleTicket(index int, ticket TicketData) Proce
 	}
 
 	if ticket.Assignee != "" {
-		fields.Assignee = &jira.User{
-			EmailAddress: ticket.Assignee,
-		}
+		assignee, err := bp.userService.ResolveAssignee(ctx, ticket.Assignee, bp.projectKey)
+		problems.Add(err)
+		fields.Assignee = assignee
 	}
 
 	if len(ticket.Labels) > 0 {
@@ -182,7 +327,42 @@ func (bp *BatchProcessor) createSingleTicket(index int, ticket TicketData) Proce
 		fields.Components = components
 	}
 
-	resp, err := issueService.CreateIssueWithFields(fields)
+	fixVersions, err := bp.versionService.ResolveVersions(ctx, bp.projectKey, ticket.FixVersions)
+	problems.Add(err)
+	fields.FixVersions = fixVersions
+
+	affectsVersions, err := bp.versionService.ResolveVersions(ctx, bp.projectKey, ticket.AffectsVersions)
+	problems.Add(err)
+	fields.Versions = affectsVersions
+
+	customFields, err := bp.fieldService.ResolveFieldValues(ctx, ticket.Fields)
+	problems.Add(err)
+
+	if ticket.ParentKey != "" {
+		parentFields, err := bp.parentService.ParentFields(ctx, ticket.ParentKey, jira.IsSubtaskType(ticket.IssueType))
+		if err != nil {
+			problems.Add(fmt.Errorf("parent validation failed: %w", err))
+		}
+		if customFields == nil && len(parentFields) > 0 {
+			customFields = make(map[string]interface{})
+		}
+		for id, value := range parentFields {
+			customFields[id] = value
+		}
+	}
+	fields.CustomFields = customFields
+
+	return fields, problems.Err()
+}
+
+// createSingleTicket creates a single ticket
+func (bp *BatchProcessor) createSingleTicket(ctx context.Context, index int, ticket TicketData) ProcessResult {
+	issueService := jira.NewIssueService(bp.client)
+
+	var stats jira.RequestStats
+	ctx = jira.WithRequestStats(ctx, &stats)
+
+	fields, err := bp.buildFields(ctx, ticket)
 	if err != nil {
 		return ProcessResult{
 			Index:      index,
@@ -192,11 +372,23 @@ func (bp *BatchProcessor) createSingleTicket(index int, ticket TicketData) Proce
 		}
 	}
 
+	resp, err := issueService.CreateIssueWithFieldsContext(ctx, fields)
+	if err != nil {
+		return ProcessResult{
+			Index:      index,
+			TicketData: ticket,
+			Error:      err,
+			Status:     "failed",
+			Retries:    stats.Retries(),
+		}
+	}
+
 	return ProcessResult{
 		Index:      index,
 		TicketData: ticket,
 		CreatedKey: resp.Key,
 		Status:     "created",
+		Retries:    stats.Retries(),
 	}
 }
 
@@ -214,11 +406,11 @@ func PrintResults(results []ProcessResult, verbose bool) {
 		case "created":
 			successCount++
 			if verbose {
-				fmt.Printf("✅ [%d] %s -> %s\n", result.Index+1, result.TicketData.Summary, result.CreatedKey)
+				fmt.Printf("✅ [%d] %s -> %s%s\n", result.Index+1, result.TicketData.Summary, result.CreatedKey, FormatRetries(result.Retries))
 			}
 		case "failed":
 			failureCount++
-			fmt.Printf("❌ [%d] %s: %v\n", result.Index+1, result.TicketData.Summary, result.Error)
+			fmt.Printf("❌ [%d] %s: %v%s\n", result.Index+1, result.TicketData.Summary, result.Error, FormatRetries(result.Retries))
 		case "partial":
 			partialCount++
 			if verbose {
@@ -238,3 +430,15 @@ func PrintResults(results []ProcessResult, verbose bool) {
 		fmt.Println("🎉 All tickets processed successfully!")
 	}
 }
+
+// FormatRetries returns a short retry annotation, or "" when there were none
+func FormatRetries(retries int) string {
+	switch retries {
+	case 0:
+		return ""
+	case 1:
+		return " (1 retry)"
+	default:
+		return fmt.Sprintf(" (%d retries)", retries)
+	}
+}
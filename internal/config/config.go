@@ -5,8 +5,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
 )
 
 // Config holds all configuration values
@@ -18,9 +21,30 @@ type Config struct {
 		Project string
 		Ticket  string // Optional: can specify ticket key instead of project
 	}
+	API struct {
+		Version int // REST API version: 2 (default) or 3 for Jira Cloud rich text (ADF)
+	}
+	Auth struct {
+		Type         string // basic (default), bearer, oauth2, or cookie
+		ClientID     string `mapstructure:"client_id"`
+		ClientSecret string `mapstructure:"client_secret"`
+		RefreshToken string `mapstructure:"refresh_token"`
+		TokenURL     string `mapstructure:"token_url"`
+		Cookie       string // Session cookie for cookie auth, e.g. JSESSIONID=abc123
+	}
+	Retry    Retry
 	Defaults Defaults
 }
 
+// Retry holds retry policy overrides; zero values keep the client defaults
+type Retry struct {
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	BaseDelay   time.Duration `mapstructure:"base_delay"`
+	MaxDelay    time.Duration `mapstructure:"max_delay"`
+	Budget      time.Duration
+	NoJitter    bool `mapstructure:"no_jitter"`
+}
+
 // LoadConfig loads configuration with the following priority:
 // 1. Command-line flags (passed via viper)
 // 2. Environment variables (JIRA_URL, JIRA_EMAIL, JIRA_TOKEN, JIRA_PROJECT)
@@ -35,6 +59,14 @@ func LoadConfig() (*Config, error) {
 	v.BindEnv("jira.token", "JIRA_TOKEN")
 	v.BindEnv("jira.project", "JIRA_PROJECT")
 	v.BindEnv("jira.ticket", "JIRA_TICKET")
+	v.BindEnv("api.version", "JIRA_API_VERSION")
+	v.BindEnv("auth.type", "JIRA_AUTH_TYPE")
+	v.BindEnv("auth.client_id", "JIRA_OAUTH_CLIENT_ID")
+	v.BindEnv("auth.client_secret", "JIRA_OAUTH_CLIENT_SECRET")
+	v.BindEnv("auth.refresh_token", "JIRA_OAUTH_REFRESH_TOKEN")
+	v.BindEnv("auth.cookie", "JIRA_SESSION_COOKIE")
+	v.BindEnv("retry.max_attempts", "JIRA_RETRY_MAX_ATTEMPTS")
+	v.BindEnv("retry.budget", "JIRA_RETRY_BUDGET")
 
 	// Set config file paths
 	v.SetConfigName(".jirarc")
@@ -75,6 +107,14 @@ func LoadConfigWithFlags(v *viper.Viper) (*Config, error) {
 	v.BindEnv("jira.token", "JIRA_TOKEN")
 	v.BindEnv("jira.project", "JIRA_PROJECT")
 	v.BindEnv("jira.ticket", "JIRA_TICKET")
+	v.BindEnv("api.version", "JIRA_API_VERSION")
+	v.BindEnv("auth.type", "JIRA_AUTH_TYPE")
+	v.BindEnv("auth.client_id", "JIRA_OAUTH_CLIENT_ID")
+	v.BindEnv("auth.client_secret", "JIRA_OAUTH_CLIENT_SECRET")
+	v.BindEnv("auth.refresh_token", "JIRA_OAUTH_REFRESH_TOKEN")
+	v.BindEnv("auth.cookie", "JIRA_SESSION_COOKIE")
+	v.BindEnv("retry.max_attempts", "JIRA_RETRY_MAX_ATTEMPTS")
+	v.BindEnv("retry.budget", "JIRA_RETRY_BUDGET")
 
 	// Set config file paths
 	v.SetConfigName(".jirarc")
@@ -108,11 +148,11 @@ func (c *Config) ValidateRequired() error {
 	if c.JIRA.URL == "" {
 		return fmt.Errorf("JIRA URL is required (set via --url flag, JIRA_URL env var, or ~/.jirarc config file)")
 	}
-	if c.JIRA.Email == "" {
-		return fmt.Errorf("JIRA email is required (set via --email flag, JIRA_EMAIL env var, or ~/.jirarc config file)")
+	if err := c.validateAuth(); err != nil {
+		return err
 	}
-	if c.JIRA.Token == "" {
-		return fmt.Errorf("JIRA token is required (set via --token flag, JIRA_TOKEN env var, or ~/.jirarc config file)")
+	if v := c.API.Version; v != 0 && v != 2 && v != 3 {
+		return fmt.Errorf("unsupported JIRA API version: %d (expected 2 or 3)", v)
 	}
 	if c.JIRA.Project == "" && c.JIRA.Ticket == "" {
 		return fmt.Errorf("JIRA project or ticket is required (set via --project/--ticket flag, JIRA_PROJECT/JIRA_TICKET env var, or ~/.jirarc config file)")
@@ -120,6 +160,51 @@ func (c *Config) ValidateRequired() error {
 	return nil
 }
 
+// AuthType returns the normalized authentication type, defaulting to basic
+func (c *Config) AuthType() string {
+	switch t := strings.ToLower(strings.TrimSpace(c.Auth.Type)); t {
+	case "":
+		return jira.AuthTypeBasic
+	case "pat":
+		return jira.AuthTypeBearer
+	case "oauth":
+		return jira.AuthTypeOAuth2
+	default:
+		return t
+	}
+}
+
+// validateAuth checks that the credentials required by the auth type are set
+func (c *Config) validateAuth() error {
+	switch c.AuthType() {
+	case jira.AuthTypeBasic:
+		if c.JIRA.Email == "" {
+			return fmt.Errorf("JIRA email is required (set via --email flag, JIRA_EMAIL env var, or ~/.jirarc config file)")
+		}
+		if c.JIRA.Token == "" {
+			return fmt.Errorf("JIRA token is required (set via --token flag, JIRA_TOKEN env var, or ~/.jirarc config file)")
+		}
+	case jira.AuthTypeBearer:
+		if c.JIRA.Token == "" {
+			return fmt.Errorf("JIRA personal access token is required for bearer auth (set via --token flag, JIRA_TOKEN env var, or ~/.jirarc config file)")
+		}
+	case jira.AuthTypeOAuth2:
+		if c.Auth.ClientID == "" || c.Auth.ClientSecret == "" {
+			return fmt.Errorf("OAuth 2.0 client ID and secret are required (set via JIRA_OAUTH_CLIENT_ID/JIRA_OAUTH_CLIENT_SECRET env vars or auth.client_id/auth.client_secret in ~/.jirarc)")
+		}
+		if c.Auth.RefreshToken == "" {
+			return fmt.Errorf("OAuth 2.0 refresh token is required (set via JIRA_OAUTH_REFRESH_TOKEN env var or auth.refresh_token in ~/.jirarc)")
+		}
+	case jira.AuthTypeCookie:
+		if c.Auth.Cookie == "" {
+			return fmt.Errorf("session cookie is required for cookie auth (set via JIRA_SESSION_COOKIE env var or auth.cookie in ~/.jirarc)")
+		}
+	default:
+		return fmt.Errorf("unknown auth type: %s (expected basic, bearer, oauth2, or cookie)", c.Auth.Type)
+	}
+	return nil
+}
+
 // GetProject returns the project key, extracting from ticket key if necessary
 // Priority: explicit project > ticket key (extracts project) > error
 func (c *Config) GetProject() (string, error) {
@@ -130,3 +130,120 @@ func TestDefaultConfig(t *testing.T) {
 		t.Errorf("DefaultConfig().Priority = %s, want Medium", defaults.Priority)
 	}
 }
+
+func TestValidateRequiredAuthTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(c *Config)
+		wantErr bool
+	}{
+		{
+			name: "bearer without email",
+			setup: func(c *Config) {
+				c.Auth.Type = "bearer"
+				c.JIRA.Token = "pat123"
+			},
+			wantErr: false,
+		},
+		{
+			name: "bearer alias pat",
+			setup: func(c *Config) {
+				c.Auth.Type = "PAT"
+				c.JIRA.Token = "pat123"
+			},
+			wantErr: false,
+		},
+		{
+			name: "bearer missing token",
+			setup: func(c *Config) {
+				c.Auth.Type = "bearer"
+			},
+			wantErr: true,
+		},
+		{
+			name: "basic missing email",
+			setup: func(c *Config) {
+				c.JIRA.Token = "token123"
+			},
+			wantErr: true,
+		},
+		{
+			name: "oauth2 complete",
+			setup: func(c *Config) {
+				c.Auth.Type = "oauth2"
+				c.Auth.ClientID = "client"
+				c.Auth.ClientSecret = "secret"
+				c.Auth.RefreshToken = "refresh"
+			},
+			wantErr: false,
+		},
+		{
+			name: "oauth2 missing refresh token",
+			setup: func(c *Config) {
+				c.Auth.Type = "oauth2"
+				c.Auth.ClientID = "client"
+				c.Auth.ClientSecret = "secret"
+			},
+			wantErr: true,
+		},
+		{
+			name: "cookie",
+			setup: func(c *Config) {
+				c.Auth.Type = "cookie"
+				c.Auth.Cookie = "JSESSIONID=abc"
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown type",
+			setup: func(c *Config) {
+				c.Auth.Type = "kerberos"
+				c.JIRA.Token = "token123"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{}
+			cfg.JIRA.URL = "https://jira.example.com"
+			cfg.JIRA.Project = "PROJ"
+			tt.setup(cfg)
+
+			err := cfg.ValidateRequired()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRequired() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRequiredAPIVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion int
+		wantErr    bool
+	}{
+		{"Default", 0, false},
+		{"v2", 2, false},
+		{"v3", 3, false},
+		{"Unsupported", 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{}
+			cfg.JIRA.URL = "https://jira.example.com"
+			cfg.JIRA.Email = "user@example.com"
+			cfg.JIRA.Token = "token123"
+			cfg.JIRA.Project = "PROJ"
+			cfg.API.Version = tt.apiVersion
+
+			err := cfg.ValidateRequired()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRequired() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -75,7 +75,7 @@ func (w *TicketWizard) Run() (*TicketInput, error) {
 	input.Priority = priority
 
 	// Prompt for assignee
-	assignee, err := PromptStringWithDefault("Assignee Email", "")
+	assignee, err := PromptStringWithDefault("Assignee (email or name)", "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get assignee: %w", err)
 	}
@@ -0,0 +1,309 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sprint states
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// MaxAgileIssuesPerRequest is the most issues the Agile API moves in one request
+const MaxAgileIssuesPerRequest = 50
+
+// Board is a Scrum or Kanban board
+type Board struct {
+	ID       int            `json:"id"`
+	Name     string         `json:"name"`
+	Type     string         `json:"type"` // "scrum" or "kanban"
+	Location *BoardLocation `json:"location,omitempty"`
+}
+
+// BoardLocation is the project a board belongs to
+type BoardLocation struct {
+	ProjectID   int    `json:"projectId,omitempty"`
+	ProjectKey  string `json:"projectKey,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// Sprint is a sprint on a Scrum board
+type Sprint struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	State         string `json:"state"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	CompleteDate  string `json:"completeDate,omitempty"`
+	OriginBoardID int    `json:"originBoardId,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+}
+
+// SprintRequest is the request body for creating a sprint
+type SprintRequest struct {
+	Name          string `json:"name"`
+	OriginBoardID int    `json:"originBoardId"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+}
+
+// BoardOptions filters the boards returned by GetBoards
+type BoardOptions struct {
+	ProjectKey string
+	Name       string // Boards whose name contains this
+	Type       string // "scrum" or "kanban"
+}
+
+// agilePage is a page of values from an Agile API list endpoint
+type agilePage[T any] struct {
+	StartAt    int  `json:"startAt"`
+	MaxResults int  `json:"maxResults"`
+	IsLast     bool `json:"isLast"`
+	Values     []T  `json:"values"`
+}
+
+// agileIssuesRequest is the request body for moving issues to a sprint or the backlog
+type agileIssuesRequest struct {
+	Issues []string `json:"issues"`
+}
+
+// AgileService handles boards, sprints and backlog operations via the Agile REST API
+type AgileService struct {
+	client *Client
+}
+
+// NewAgileService creates a new agile service
+func NewAgileService(client *Client) *AgileService {
+	return &AgileService{client: client}
+}
+
+// getAllPages collects every value of a paginated Agile API list endpoint
+func getAllPages[T any](ctx context.Context, c *Client, path string, params url.Values) ([]T, error) {
+	var values []T
+	for {
+		params.Set("startAt", strconv.Itoa(len(values)))
+		var page agilePage[T]
+		if err := c.DoContext(ctx, "GET", path+"?"+params.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+
+		values = append(values, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return values, nil
+		}
+	}
+}
+
+// GetBoards retrieves the boards matching opts
+func (s *AgileService) GetBoards(ctx context.Context, opts BoardOptions) ([]Board, error) {
+	params := url.Values{}
+	if opts.ProjectKey != "" {
+		params.Set("projectKeyOrId", opts.ProjectKey)
+	}
+	if opts.Name != "" {
+		params.Set("name", opts.Name)
+	}
+	if opts.Type != "" {
+		params.Set("type", opts.Type)
+	}
+
+	boards, err := getAllPages[Board](ctx, s.client, "/rest/agile/1.0/board", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get boards: %w", err)
+	}
+	return boards, nil
+}
+
+// ResolveBoard finds a board by ID or exact name, or when nameOrID is empty,
+// the only Scrum board of projectKey
+func (s *AgileService) ResolveBoard(ctx context.Context, nameOrID, projectKey string) (*Board, error) {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		var board Board
+		if err := s.client.DoContext(ctx, "GET", fmt.Sprintf("/rest/agile/1.0/board/%d", id), nil, &board); err != nil {
+			return nil, fmt.Errorf("failed to get board %d: %w", id, err)
+		}
+		return &board, nil
+	}
+
+	opts := BoardOptions{Name: nameOrID}
+	if nameOrID == "" {
+		opts = BoardOptions{ProjectKey: projectKey, Type: "scrum"}
+	}
+	boards, err := s.GetBoards(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Board
+	for _, b := range boards {
+		if nameOrID == "" || strings.EqualFold(b.Name, nameOrID) {
+			matches = append(matches, b)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return &matches[0], nil
+	case 0:
+		if nameOrID == "" {
+			return nil, fmt.Errorf("project %s has no Scrum board; choose one with --board", projectKey)
+		}
+		return nil, fmt.Errorf("board not found: %s", nameOrID)
+	default:
+		var names []string
+		for _, b := range matches {
+			names = append(names, fmt.Sprintf("%d (%s)", b.ID, b.Name))
+		}
+		return nil, fmt.Errorf("several boards match; choose one with --board: %s", strings.Join(names, ", "))
+	}
+}
+
+// GetSprints retrieves a board's sprints, optionally only those in the given states
+func (s *AgileService) GetSprints(ctx context.Context, boardID int, states ...string) ([]Sprint, error) {
+	params := url.Values{}
+	if len(states) > 0 {
+		params.Set("state", strings.Join(states, ","))
+	}
+
+	path := fmt.Sprintf("/rest/agile/1.0/board/%d/sprint", boardID)
+	sprints, err := getAllPages[Sprint](ctx, s.client, path, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sprints for board %d: %w", boardID, err)
+	}
+	return sprints, nil
+}
+
+// GetSprint retrieves a sprint by ID
+func (s *AgileService) GetSprint(ctx context.Context, id int) (*Sprint, error) {
+	var sprint Sprint
+	if err := s.client.DoContext(ctx, "GET", fmt.Sprintf("/rest/agile/1.0/sprint/%d", id), nil, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to get sprint %d: %w", id, err)
+	}
+	return &sprint, nil
+}
+
+// FindSprint finds a sprint by ID, by name among the board's open sprints,
+// or by the keywords "active" (the current sprint) and "next" (the first future sprint)
+func (s *AgileService) FindSprint(ctx context.Context, boardID int, nameOrID string) (*Sprint, error) {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		return s.GetSprint(ctx, id)
+	}
+	if boardID == 0 {
+		return nil, fmt.Errorf("a board is needed to find sprint %q by name", nameOrID)
+	}
+
+	sprints, err := s.GetSprints(ctx, boardID, SprintStateActive, SprintStateFuture)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sprints {
+		switch {
+		case strings.EqualFold(nameOrID, SprintStateActive) && sprints[i].State == SprintStateActive,
+			strings.EqualFold(nameOrID, "next") && sprints[i].State == SprintStateFuture,
+			strings.EqualFold(sprints[i].Name, nameOrID):
+			return &sprints[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no open sprint %q on board %d", nameOrID, boardID)
+}
+
+// CreateSprint creates a future sprint on a board
+func (s *AgileService) CreateSprint(ctx context.Context, req SprintRequest) (*Sprint, error) {
+	var sprint Sprint
+	if err := s.client.DoContext(ctx, "POST", "/rest/agile/1.0/sprint", req, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to create sprint %s: %w", req.Name, err)
+	}
+	return &sprint, nil
+}
+
+// updateSprint partially updates a sprint, e.g. to change its state
+func (s *AgileService) updateSprint(ctx context.Context, id int, changes map[string]string) (*Sprint, error) {
+	var sprint Sprint
+	if err := s.client.DoContext(ctx, "POST", fmt.Sprintf("/rest/agile/1.0/sprint/%d", id), changes, &sprint); err != nil {
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// StartSprint makes a future sprint active, running from start to end
+func (s *AgileService) StartSprint(ctx context.Context, id int, start, end time.Time) (*Sprint, error) {
+	sprint, err := s.updateSprint(ctx, id, map[string]string{
+		"state":     SprintStateActive,
+		"startDate": start.Format(time.RFC3339),
+		"endDate":   end.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start sprint %d: %w", id, err)
+	}
+	return sprint, nil
+}
+
+// CloseSprint completes an active sprint
+func (s *AgileService) CloseSprint(ctx context.Context, id int) (*Sprint, error) {
+	sprint, err := s.updateSprint(ctx, id, map[string]string{"state": SprintStateClosed})
+	if err != nil {
+		return nil, fmt.Errorf("failed to close sprint %d: %w", id, err)
+	}
+	return sprint, nil
+}
+
+// moveIssues posts issue keys to an Agile API endpoint in chunks of MaxAgileIssuesPerRequest
+func (s *AgileService) moveIssues(ctx context.Context, path string, keys []string) error {
+	for start := 0; start < len(keys); start += MaxAgileIssuesPerRequest {
+		end := start + MaxAgileIssuesPerRequest
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if err := s.client.DoContext(ctx, "POST", path, agileIssuesRequest{Issues: keys[start:end]}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MoveToSprint moves issues into a sprint
+func (s *AgileService) MoveToSprint(ctx context.Context, sprintID int, keys ...string) error {
+	path := fmt.Sprintf("/rest/agile/1.0/sprint/%d/issue", sprintID)
+	if err := s.moveIssues(ctx, path, keys); err != nil {
+		return fmt.Errorf("failed to move issues to sprint %d: %w", sprintID, err)
+	}
+	return nil
+}
+
+// MoveToBacklog moves issues out of their sprints and into the backlog
+func (s *AgileService) MoveToBacklog(ctx context.Context, keys ...string) error {
+	if err := s.moveIssues(ctx, "/rest/agile/1.0/backlog/issue", keys); err != nil {
+		return fmt.Errorf("failed to move issues to the backlog: %w", err)
+	}
+	return nil
+}
+
+// GetSprintIssues retrieves every issue in a sprint
+func (s *AgileService) GetSprintIssues(ctx context.Context, sprintID int, fields []string) ([]Issue, error) {
+	var issues []Issue
+	for {
+		params := fieldParams(fields, nil)
+		params.Set("startAt", strconv.Itoa(len(issues)))
+		params.Set("maxResults", strconv.Itoa(DefaultSearchPageSize))
+
+		var page SearchResponse
+		path := fmt.Sprintf("/rest/agile/1.0/sprint/%d/issue?%s", sprintID, params.Encode())
+		if err := s.client.DoContext(ctx, "GET", path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get issues in sprint %d: %w", sprintID, err)
+		}
+
+		issues = append(issues, page.Issues...)
+		if len(page.Issues) == 0 || len(issues) >= page.Total {
+			return issues, nil
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetSprintsPaginates(t *testing.T) {
+	var gotStates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/agile/1.0/board/7/sprint" {
+			t.Errorf("request path = %s", r.URL.Path)
+		}
+		gotStates = append(gotStates, r.URL.Query().Get("state"))
+		if r.URL.Query().Get("startAt") == "0" {
+			w.Write([]byte(`{"startAt":0,"isLast":false,"values":[{"id":1,"name":"Sprint 1","state":"active"}]}`))
+			return
+		}
+		w.Write([]byte(`{"startAt":1,"isLast":true,"values":[{"id":2,"name":"Sprint 2","state":"future"}]}`))
+	}))
+	defer server.Close()
+
+	service := NewAgileService(NewClient(server.URL, "user@example.com", "token"))
+	sprints, err := service.GetSprints(context.Background(), 7, SprintStateActive, SprintStateFuture)
+	if err != nil {
+		t.Fatalf("GetSprints() error = %v", err)
+	}
+
+	if len(sprints) != 2 || sprints[1].Name != "Sprint 2" {
+		t.Errorf("sprints = %+v", sprints)
+	}
+	if len(gotStates) != 2 || gotStates[0] != "active,future" {
+		t.Errorf("state params = %v", gotStates)
+	}
+}
+
+func TestFindSprint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/agile/1.0/sprint/42":
+			w.Write([]byte(`{"id":42,"name":"By ID","state":"closed"}`))
+		case "/rest/agile/1.0/board/7/sprint":
+			w.Write([]byte(`{"isLast":true,"values":[
+				{"id":1,"name":"Sprint 1","state":"active"},
+				{"id":2,"name":"Sprint 2","state":"future"},
+				{"id":3,"name":"Sprint 3","state":"future"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	service := NewAgileService(NewClient(server.URL, "user@example.com", "token"))
+
+	tests := []struct {
+		board     int
+		nameOrID  string
+		expected  int
+		wantError bool
+	}{
+		{0, "42", 42, false},
+		{7, "active", 1, false},
+		{7, "next", 2, false},
+		{7, "sprint 3", 3, false},
+		{7, "Sprint 9", 0, true},
+		{0, "Sprint 1", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.nameOrID, func(t *testing.T) {
+			sprint, err := service.FindSprint(context.Background(), tt.board, tt.nameOrID)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("FindSprint(%d, %q) error = %v, wantError %v", tt.board, tt.nameOrID, err, tt.wantError)
+			}
+			if !tt.wantError && sprint.ID != tt.expected {
+				t.Errorf("FindSprint(%d, %q) = %d, want %d", tt.board, tt.nameOrID, sprint.ID, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResolveBoard(t *testing.T) {
+	tests := []struct {
+		name      string
+		boards    string
+		nameOrID  string
+		expected  int
+		wantError string
+	}{
+		{"only scrum board", `[{"id":7,"name":"PROJ board","type":"scrum"}]`, "", 7, ""},
+		{"no board", `[]`, "", 0, "has no Scrum board"},
+		{"several boards", `[{"id":7,"name":"A"},{"id":8,"name":"B"}]`, "", 0, "7 (A), 8 (B)"},
+		{"by name", `[{"id":7,"name":"Team A"},{"id":8,"name":"Team A (old)"}]`, "team a", 7, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.nameOrID == "" && (r.URL.Query().Get("projectKeyOrId") != "PROJ" || r.URL.Query().Get("type") != "scrum") {
+					t.Errorf("query = %s", r.URL.RawQuery)
+				}
+				fmt.Fprintf(w, `{"isLast":true,"values":%s}`, tt.boards)
+			}))
+			defer server.Close()
+
+			service := NewAgileService(NewClient(server.URL, "user@example.com", "token"))
+			board, err := service.ResolveBoard(context.Background(), tt.nameOrID, "PROJ")
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveBoard() error = %v", err)
+			}
+			if board.ID != tt.expected {
+				t.Errorf("board = %d, want %d", board.ID, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMoveToSprintChunks(t *testing.T) {
+	var batches [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/agile/1.0/sprint/5/issue" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		var req agileIssuesRequest
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &req)
+		batches = append(batches, req.Issues)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	keys := make([]string, 120)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("PROJ-%d", i+1)
+	}
+
+	service := NewAgileService(NewClient(server.URL, "user@example.com", "token"))
+	if err := service.MoveToSprint(context.Background(), 5, keys...); err != nil {
+		t.Fatalf("MoveToSprint() error = %v", err)
+	}
+
+	if len(batches) != 3 || len(batches[0]) != 50 || len(batches[2]) != 20 || batches[2][19] != "PROJ-120" {
+		t.Errorf("got %d batches", len(batches))
+	}
+}
+
+func TestStartSprint(t *testing.T) {
+	var got map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/agile/1.0/sprint/5" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &got)
+		w.Write([]byte(`{"id":5,"state":"active"}`))
+	}))
+	defer server.Close()
+
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	service := NewAgileService(NewClient(server.URL, "user@example.com", "token"))
+	if _, err := service.StartSprint(context.Background(), 5, start, start.AddDate(0, 0, 14)); err != nil {
+		t.Fatalf("StartSprint() error = %v", err)
+	}
+
+	if got["state"] != "active" || got["startDate"] != "2026-10-19T09:00:00Z" || got["endDate"] != "2026-11-02T09:00:00Z" {
+		t.Errorf("request body = %v", got)
+	}
+}
@@ -0,0 +1,208 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Attachment represents a file attached to a JIRA issue
+type Attachment struct {
+	ID        string `json:"id"`
+	Self      string `json:"self,omitempty"`
+	Filename  string `json:"filename"`
+	Author    *User  `json:"author,omitempty"`
+	Created   string `json:"created,omitempty"`
+	Size      int64  `json:"size"`
+	MimeType  string `json:"mimeType,omitempty"`
+	Content   string `json:"content,omitempty"`   // Download URL
+	Thumbnail string `json:"thumbnail,omitempty"` // Thumbnail URL for images
+}
+
+// UploadFile is a file to upload as an attachment
+type UploadFile struct {
+	Name string                        // Filename shown in JIRA
+	Open func() (io.ReadCloser, error) // Called once per attempt that sends the file
+}
+
+// FileUpload returns an UploadFile that streams the file at path from disk
+func FileUpload(path string) UploadFile {
+	return UploadFile{
+		Name: filepath.Base(path),
+		Open: func() (io.ReadCloser, error) { return os.Open(path) },
+	}
+}
+
+// multipartBody is a request body streamed as multipart/form-data
+type multipartBody struct {
+	field string
+	files []UploadFile
+}
+
+// writeTo streams the multipart body into pw from a new goroutine, returning the content type
+// Any error opening or copying a file aborts the request through the pipe
+func (b *multipartBody) writeTo(pw *io.PipeWriter) string {
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		for _, file := range b.files {
+			if err := writePart(mw, b.field, file); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.CloseWithError(mw.Close())
+	}()
+
+	return mw.FormDataContentType()
+}
+
+// writePart copies a single file into a multipart writer
+func writePart(mw *multipart.Writer, field string, file UploadFile) error {
+	r, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer r.Close()
+
+	part, err := mw.CreateFormFile(field, file.Name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+	return nil
+}
+
+// AttachmentService handles JIRA issue attachment operations
+type AttachmentService struct {
+	client *Client
+}
+
+// NewAttachmentService creates a new attachment service
+func NewAttachmentService(client *Client) *AttachmentService {
+	return &AttachmentService{client: client}
+}
+
+// AddAttachments uploads files to an issue in a single streaming multipart request
+//
+// A failed upload may still have attached some files, so before each retry the
+// issue's attachments are listed and only the files JIRA does not have yet are sent
+// again. Attachments that existed before the upload never count as uploaded.
+func (s *AttachmentService) AddAttachments(ctx context.Context, key string, files ...UploadFile) ([]Attachment, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	existing, err := s.GetAttachments(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, a := range existing {
+		seen[a.ID] = true
+	}
+
+	path := fmt.Sprintf("/rest/api/2/issue/%s/attachments", key)
+
+	// Each attempt streams its own body, since an earlier attempt's writer may
+	// still be running when the pending files change
+	pending := files
+	body := bodyFunc(func() interface{} {
+		return &multipartBody{field: "file", files: pending}
+	})
+
+	var uploaded []Attachment
+	beforeRetry := func(ctx context.Context) (bool, error) {
+		current, err := s.GetAttachments(ctx, key)
+		if err != nil {
+			return true, fmt.Errorf("files may have been attached to %s; check it before retrying: %w", key, err)
+		}
+
+		var remaining []UploadFile
+		for _, file := range pending {
+			if a := newAttachment(current, seen, file.Name); a != nil {
+				seen[a.ID] = true
+				uploaded = append(uploaded, *a)
+			} else {
+				remaining = append(remaining, file)
+			}
+		}
+		pending = remaining
+		return len(pending) == 0, nil
+	}
+
+	var attachments []Attachment
+	if err := s.client.doWithRetry(ctx, "POST", path, body, &attachments, beforeRetry); err != nil {
+		return nil, fmt.Errorf("failed to attach files to %s: %w", key, err)
+	}
+	return append(uploaded, attachments...), nil
+}
+
+// newAttachment returns the attachment named name whose ID is not in seen, or nil
+func newAttachment(attachments []Attachment, seen map[string]bool, name string) *Attachment {
+	for i, a := range attachments {
+		if a.Filename == name && !seen[a.ID] {
+			return &attachments[i]
+		}
+	}
+	return nil
+}
+
+// AddAttachmentFiles uploads files from disk to an issue
+func (s *AttachmentService) AddAttachmentFiles(ctx context.Context, key string, paths ...string) ([]Attachment, error) {
+	files := make([]UploadFile, len(paths))
+	for i, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("failed to attach %s: %w", path, err)
+		}
+		files[i] = FileUpload(path)
+	}
+	return s.AddAttachments(ctx, key, files...)
+}
+
+// GetAttachments lists the attachments on an issue
+func (s *AttachmentService) GetAttachments(ctx context.Context, key string) ([]Attachment, error) {
+	issue, err := s.client.GetIssueWithOptions(ctx, key, GetOptions{Fields: []string{"attachment"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments for %s: %w", key, err)
+	}
+	return issue.Fields.Attachment, nil
+}
+
+// GetAttachment retrieves an attachment's metadata by ID
+func (s *AttachmentService) GetAttachment(ctx context.Context, id string) (*Attachment, error) {
+	var attachment Attachment
+	path := fmt.Sprintf("/rest/api/2/attachment/%s", id)
+	if err := s.client.DoContext(ctx, "GET", path, nil, &attachment); err != nil {
+		return nil, fmt.Errorf("failed to get attachment %s: %w", id, err)
+	}
+	return &attachment, nil
+}
+
+// DownloadAttachment streams an attachment's content into w
+func (s *AttachmentService) DownloadAttachment(ctx context.Context, attachment Attachment, w io.Writer) error {
+	path := fmt.Sprintf("/secure/attachment/%s/%s", attachment.ID, attachment.Filename)
+	if strings.HasPrefix(attachment.Content, s.client.BaseURL) {
+		path = strings.TrimPrefix(attachment.Content, s.client.BaseURL)
+	}
+
+	if err := s.client.DoContext(ctx, "GET", path, nil, w); err != nil {
+		return fmt.Errorf("failed to download attachment %s: %w", attachment.Filename, err)
+	}
+	return nil
+}
+
+// DeleteAttachment deletes an attachment by ID
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/rest/api/2/attachment/%s", id)
+	if err := s.client.DoContext(ctx, "DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete attachment %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAddAttachmentFiles(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "build.log")
+	pngPath := filepath.Join(dir, "screen.png")
+	os.WriteFile(logPath, []byte("line 1\nline 2\n"), 0644)
+	os.WriteFile(pngPath, []byte("\x89PNG fake"), 0644)
+
+	attempts := 0
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"key":"PROJ-1","fields":{"attachment":[]}}`)
+			return
+		}
+		attempts++
+		if r.URL.Path != "/rest/api/2/issue/PROJ-1/attachments" {
+			t.Errorf("request path = %s", r.URL.Path)
+		}
+		if r.Header.Get("X-Atlassian-Token") != "no-check" {
+			t.Errorf("X-Atlassian-Token = %q, want no-check", r.Header.Get("X-Atlassian-Token"))
+		}
+
+		reader, err := r.MultipartReader()
+		if err != nil {
+			t.Fatalf("MultipartReader() error = %v", err)
+		}
+		received = map[string]string{}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("NextPart() error = %v", err)
+			}
+			if part.FormName() != "file" {
+				t.Errorf("form field = %s, want file", part.FormName())
+			}
+			data, _ := io.ReadAll(part)
+			received[part.FileName()] = string(data)
+		}
+
+		// Fail the first attempt so the upload has to be streamed again
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"1","filename":"build.log","size":14},{"id":"2","filename":"screen.png","size":9}]`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry = fastRetryPolicy()
+	service := NewAttachmentService(client)
+
+	attachments, err := service.AddAttachmentFiles(context.Background(), "PROJ-1", logPath, pngPath)
+	if err != nil {
+		t.Fatalf("AddAttachmentFiles() error = %v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("made %d attempts, want 2", attempts)
+	}
+	if len(attachments) != 2 || attachments[1].Filename != "screen.png" {
+		t.Errorf("attachments = %+v", attachments)
+	}
+	if received["build.log"] != "line 1\nline 2\n" || received["screen.png"] != "\x89PNG fake" {
+		t.Errorf("received = %q", received)
+	}
+}
+
+func TestAddAttachmentsRetrySkipsAttachedFiles(t *testing.T) {
+	var attached []string
+	var posted [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			var items []string
+			for i, name := range attached {
+				items = append(items, fmt.Sprintf(`{"id":"%d","filename":%q}`, i+1, name))
+			}
+			fmt.Fprintf(w, `{"key":"PROJ-1","fields":{"attachment":[%s]}}`, strings.Join(items, ","))
+			return
+		}
+
+		reader, _ := r.MultipartReader()
+		var names []string
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			names = append(names, part.FileName())
+		}
+		posted = append(posted, names)
+
+		// The first upload attaches only the first file before failing
+		if len(posted) == 1 {
+			attached = append(attached, names[0])
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		attached = append(attached, names...)
+		fmt.Fprintf(w, `[{"id":"%d","filename":%q}]`, len(attached), names[0])
+	}))
+	defer server.Close()
+
+	// An older attachment with the same name must not count as uploaded
+	attached = []string{"a.txt"}
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry = fastRetryPolicy()
+	service := NewAttachmentService(client)
+
+	upload := func(name string) UploadFile {
+		return UploadFile{Name: name, Open: func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader(name)), nil }}
+	}
+	attachments, err := service.AddAttachments(context.Background(), "PROJ-1", upload("a.txt"), upload("b.txt"))
+	if err != nil {
+		t.Fatalf("AddAttachments() error = %v", err)
+	}
+
+	if len(posted) != 2 || strings.Join(posted[1], ",") != "b.txt" {
+		t.Errorf("posted = %q, want the retry to send only b.txt", posted)
+	}
+	if len(attachments) != 2 || attachments[0].ID != "2" || attachments[1].Filename != "b.txt" {
+		t.Errorf("attachments = %+v", attachments)
+	}
+}
+
+func TestAddAttachmentFilesMissingFile(t *testing.T) {
+	service := NewAttachmentService(NewClient("http://unused", "user@example.com", "token"))
+	if _, err := service.AddAttachmentFiles(context.Background(), "PROJ-1", filepath.Join(t.TempDir(), "missing.log")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	content := strings.Repeat("x", 1<<16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/issue/PROJ-1":
+			fmt.Fprintf(w, `{"key":"PROJ-1","fields":{"attachment":[{"id":"10","filename":"big.txt","content":"http://%s/secure/attachment/10/big.txt"}]}}`, r.Host)
+		case "/secure/attachment/10/big.txt":
+			io.WriteString(w, content)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	service := NewAttachmentService(NewClient(server.URL, "user@example.com", "token"))
+
+	attachments, err := service.GetAttachments(context.Background(), "PROJ-1")
+	if err != nil {
+		t.Fatalf("GetAttachments() error = %v", err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("got %d attachments, want 1", len(attachments))
+	}
+
+	var buf bytes.Buffer
+	if err := service.DownloadAttachment(context.Background(), attachments[0], &buf); err != nil {
+		t.Fatalf("DownloadAttachment() error = %v", err)
+	}
+	if buf.String() != content {
+		t.Errorf("downloaded %d bytes, want %d", buf.Len(), len(content))
+	}
+}
@@ -0,0 +1,213 @@
+package jira
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authentication types accepted by NewAuthenticator and the auth.type setting
+const (
+	AuthTypeBasic  = "basic"
+	AuthTypeBearer = "bearer"
+	AuthTypeOAuth2 = "oauth2"
+	AuthTypeCookie = "cookie"
+)
+
+// DefaultOAuth2TokenURL is the Atlassian token endpoint used for 3LO refreshes
+const DefaultOAuth2TokenURL = "https://auth.atlassian.com/oauth/token"
+
+// Authenticator adds credentials to outgoing JIRA requests
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// BasicAuth authenticates with an email and API token (Jira Cloud)
+type BasicAuth struct {
+	Email string
+	Token string
+}
+
+// Authenticate sets the Basic authorization header
+func (a *BasicAuth) Authenticate(req *http.Request) error {
+	auth := base64.StdEncoding.EncodeToString([]byte(a.Email + ":" + a.Token))
+	req.Header.Set("Authorization", "Basic "+auth)
+	return nil
+}
+
+// BearerAuth authenticates with a Personal Access Token (Jira Server/Data Center)
+type BearerAuth struct {
+	Token string
+}
+
+// Authenticate sets the Bearer authorization header
+func (a *BearerAuth) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// CookieAuth authenticates with an existing session cookie, e.g. "JSESSIONID=abc123"
+// This is useful for SSO-backed instances where API tokens are unavailable
+type CookieAuth struct {
+	Cookie string
+}
+
+// Authenticate attaches the session cookie to the request
+func (a *CookieAuth) Authenticate(req *http.Request) error {
+	name, value, ok := strings.Cut(a.Cookie, "=")
+	if !ok || strings.TrimSpace(name) == "" {
+		return fmt.Errorf("invalid session cookie %q (expected NAME=VALUE)", a.Cookie)
+	}
+	req.AddCookie(&http.Cookie{Name: strings.TrimSpace(name), Value: strings.TrimSpace(value)})
+	return nil
+}
+
+// OAuth2Auth authenticates with an OAuth 2.0 (3LO) access token obtained from a refresh token
+// The access token is fetched lazily and refreshed shortly before it expires.
+// Atlassian rotates refresh tokens, so OnRefresh should persist the new one.
+type OAuth2Auth struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	TokenURL     string
+	HTTPClient   *http.Client
+
+	// OnRefresh is called with the new refresh token after every successful refresh
+	OnRefresh func(refreshToken string)
+
+	mu          sync.Mutex
+	accessToken string
+	expiry      time.Time
+}
+
+// oauth2TokenResponse is the response from the OAuth 2.0 token endpoint
+type oauth2TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	Error        string `json:"error"`
+	ErrorDesc    string `json:"error_description"`
+}
+
+// Authenticate sets the Bearer authorization header, refreshing the access token if needed
+func (a *OAuth2Auth) Authenticate(req *http.Request) error {
+	token, err := a.token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// token returns a valid access token, refreshing it when missing or about to expire
+func (a *OAuth2Auth) token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.accessToken != "" && time.Until(a.expiry) > 30*time.Second {
+		return a.accessToken, nil
+	}
+
+	tokenURL := a.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultOAuth2TokenURL
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("client_id", a.ClientID)
+	form.Set("client_secret", a.ClientSecret)
+	form.Set("refresh_token", a.RefreshToken)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := a.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token refresh failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token response: %w", err)
+	}
+
+	var tokenResp oauth2TokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", fmt.Errorf("failed to parse OAuth 2.0 token response (HTTP %d): %w", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 || tokenResp.AccessToken == "" {
+		msg := tokenResp.ErrorDesc
+		if msg == "" {
+			msg = tokenResp.Error
+		}
+		if msg == "" {
+			msg = fmt.Sprintf("HTTP %d", resp.StatusCode)
+		}
+		return "", &AuthenticationError{Message: "OAuth 2.0 token refresh failed: " + msg}
+	}
+
+	a.accessToken = tokenResp.AccessToken
+	a.expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+
+	if tokenResp.RefreshToken != "" && tokenResp.RefreshToken != a.RefreshToken {
+		a.RefreshToken = tokenResp.RefreshToken
+		if a.OnRefresh != nil {
+			a.OnRefresh(tokenResp.RefreshToken)
+		}
+	}
+
+	return a.accessToken, nil
+}
+
+// AuthOptions holds the credentials used to build an Authenticator
+type AuthOptions struct {
+	Type         string
+	Email        string
+	Token        string
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	TokenURL     string
+	Cookie       string
+}
+
+// NewAuthenticator creates an Authenticator for the given auth type
+// An empty type defaults to basic authentication
+func NewAuthenticator(opts AuthOptions) (Authenticator, error) {
+	switch strings.ToLower(strings.TrimSpace(opts.Type)) {
+	case "", AuthTypeBasic:
+		return &BasicAuth{Email: opts.Email, Token: opts.Token}, nil
+	case AuthTypeBearer:
+		return &BearerAuth{Token: opts.Token}, nil
+	case AuthTypeOAuth2:
+		return &OAuth2Auth{
+			ClientID:     opts.ClientID,
+			ClientSecret: opts.ClientSecret,
+			RefreshToken: opts.RefreshToken,
+			TokenURL:     opts.TokenURL,
+		}, nil
+	case AuthTypeCookie:
+		return &CookieAuth{Cookie: opts.Cookie}, nil
+	default:
+		return nil, fmt.Errorf("unknown auth type: %s (expected basic, bearer, oauth2, or cookie)", opts.Type)
+	}
+}
@@ -0,0 +1,121 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuthenticators(t *testing.T) {
+	tests := []struct {
+		name       string
+		auth       Authenticator
+		wantHeader string
+		wantCookie string
+	}{
+		{"Basic", &BasicAuth{Email: "user@example.com", Token: "token"}, "Basic dXNlckBleGFtcGxlLmNvbTp0b2tlbg==", ""},
+		{"Bearer", &BearerAuth{Token: "pat123"}, "Bearer pat123", ""},
+		{"Cookie", &CookieAuth{Cookie: "JSESSIONID=abc123"}, "", "abc123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != tt.wantHeader {
+					t.Errorf("Authorization = %q, want %q", got, tt.wantHeader)
+				}
+				if tt.wantCookie != "" {
+					cookie, err := r.Cookie("JSESSIONID")
+					if err != nil || cookie.Value != tt.wantCookie {
+						t.Errorf("JSESSIONID cookie = %v, want %s", cookie, tt.wantCookie)
+					}
+				}
+				w.Write([]byte(`{"key":"PROJ-1"}`))
+			}))
+			defer server.Close()
+
+			client := NewClientWithAuth(server.URL, tt.auth)
+			if _, err := client.GetIssue("PROJ-1"); err != nil {
+				t.Fatalf("GetIssue() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestCookieAuthInvalid(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	if err := (&CookieAuth{Cookie: "no-equals-sign"}).Authenticate(req); err == nil {
+		t.Error("Authenticate() expected error for malformed cookie")
+	}
+}
+
+func TestOAuth2AuthRefresh(t *testing.T) {
+	refreshes := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("grant_type") != "refresh_token" {
+			t.Errorf("grant_type = %s, want refresh_token", r.Form.Get("grant_type"))
+		}
+		refreshes++
+		fmt.Fprintf(w, `{"access_token":"access-%d","refresh_token":"refresh-%d","expires_in":3600}`, refreshes, refreshes)
+	}))
+	defer tokenServer.Close()
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access-1" {
+			t.Errorf("Authorization = %q, want Bearer access-1", got)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer apiServer.Close()
+
+	var rotated string
+	auth := &OAuth2Auth{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh-0",
+		TokenURL:     tokenServer.URL,
+		OnRefresh:    func(token string) { rotated = token },
+	}
+
+	client := NewClientWithAuth(apiServer.URL, auth)
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetIssue("PROJ-1"); err != nil {
+			t.Fatalf("GetIssue() error = %v", err)
+		}
+	}
+
+	if refreshes != 1 {
+		t.Errorf("token endpoint called %d times, want 1", refreshes)
+	}
+	if rotated != "refresh-1" {
+		t.Errorf("OnRefresh got %q, want refresh-1", rotated)
+	}
+}
+
+func TestOAuth2AuthInvalidTokenResponse(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html>Service Unavailable</html>`))
+	}))
+	defer tokenServer.Close()
+
+	auth := &OAuth2Auth{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh-0",
+		TokenURL:     tokenServer.URL,
+	}
+
+	err := auth.Authenticate(httptest.NewRequest("GET", "/", nil))
+	if err == nil || !strings.Contains(err.Error(), "failed to parse OAuth 2.0 token response (HTTP 200)") {
+		t.Errorf("Authenticate() error = %v, want token response parse error", err)
+	}
+}
+
+func TestNewAuthenticatorUnknownType(t *testing.T) {
+	if _, err := NewAuthenticator(AuthOptions{Type: "kerberos"}); err == nil {
+		t.Error("NewAuthenticator() expected error for unknown type")
+	}
+}
@@ -0,0 +1,197 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+)
+
+// MaxBulkCreate is the most issues JIRA accepts in one bulk create request
+const MaxBulkCreate = 50
+
+// errBulkCreateInterrupted stops retrying a bulk create that failed after creating some issues
+var errBulkCreateInterrupted = errors.New("bulk create failed after creating some issues")
+
+// bulkCreatePayload is the request body for creating several issues at once
+type bulkCreatePayload struct {
+	IssueUpdates []issuePayload `json:"issueUpdates"`
+}
+
+// bulkCreateResponse lists the created issues, in request order, and the elements that failed
+type bulkCreateResponse struct {
+	Issues []CreateIssueResponse `json:"issues"`
+	Errors []bulkCreateError     `json:"errors"`
+}
+
+// bulkCreateError identifies an element of a bulk create that failed
+// Its field errors are not kept: the element is retried alone, which reports them
+type bulkCreateError struct {
+	Status              int `json:"status"`
+	FailedElementNumber int `json:"failedElementNumber"` // Index in the request's issueUpdates
+}
+
+// BulkCreateResult is the outcome of creating one issue of a bulk create
+type BulkCreateResult struct {
+	Index    int // Index of the issue's fields in the CreateIssuesBulk argument
+	Response *CreateIssueResponse
+	Err      error
+}
+
+// CreateIssuesBulk creates issues through the bulk endpoint, MaxBulkCreate per request,
+// returning one result per element of fields in the same order
+//
+// Elements the server rejects are retried one at a time with CreateIssueWithFieldsContext,
+// which reports a precise error per issue. The same fallback is used for a whole chunk
+// when the request fails, e.g. on servers without the bulk endpoint. When Idempotent is
+// set, each issue is tagged with a label so that after an ambiguous failure, issues the
+// server did create are found instead of being created twice.
+func (s *IssueService) CreateIssuesBulk(ctx context.Context, fields []IssueFields) []BulkCreateResult {
+	results := make([]BulkCreateResult, len(fields))
+	for start := 0; start < len(fields); start += MaxBulkCreate {
+		end := start + MaxBulkCreate
+		if end > len(fields) {
+			end = len(fields)
+		}
+
+		for i, result := range s.createChunk(ctx, fields[start:end]) {
+			result.Index = start + i
+			results[start+i] = result
+		}
+	}
+	return results
+}
+
+// createChunk creates up to MaxBulkCreate issues in one request, falling back to
+// single creates for any element that was not created
+func (s *IssueService) createChunk(ctx context.Context, fields []IssueFields) []BulkCreateResult {
+	results := make([]BulkCreateResult, len(fields))
+
+	if err := ctx.Err(); err != nil {
+		for i := range results {
+			results[i].Err = err
+		}
+		return results
+	}
+
+	// Tag each issue so an ambiguous failure can be resolved by label
+	labels := make([]string, len(fields))
+	payload := bulkCreatePayload{IssueUpdates: make([]issuePayload, len(fields))}
+	for i, f := range fields {
+		if s.Idempotent && !hasIdempotencyLabel(f.Labels) {
+			if token, err := NewIdempotencyToken(); err == nil {
+				labels[i] = idempotencyLabel(token)
+				f.Labels = append(append([]string{}, f.Labels...), labels[i])
+			}
+		}
+		payload.IssueUpdates[i] = s.client.newIssuePayload(f)
+	}
+
+	// Before retrying a failed request, check whether it created anything; if it
+	// did, stop and let the fallback find each created issue by its label. When
+	// the check itself fails, nothing is posted again since it may duplicate issues
+	var beforeRetry beforeRetryFunc
+	if tagged := nonEmpty(labels); len(tagged) > 0 {
+		beforeRetry = func(ctx context.Context) (bool, error) {
+			query := jql.New().Where(jql.In("labels", jql.Strings(tagged...)...))
+			found, err := s.client.GetIssueByJQLContext(ctx, query.String(), 0, 1)
+			if err != nil {
+				return true, fmt.Errorf("%w: could not search for the bulk create's labels: %w", ErrCreateUnconfirmed, err)
+			}
+			if len(found.Issues) == 0 {
+				return false, nil
+			}
+			return true, errBulkCreateInterrupted
+		}
+	}
+
+	var resp bulkCreateResponse
+	err := s.client.doWithRetry(ctx, "POST", "/rest/api/2/issue/bulk", payload, &resp, beforeRetry)
+
+	var authErr *AuthenticationError
+	switch {
+	case err != nil && (errors.As(err, &authErr) || errors.Is(err, ErrCreateUnconfirmed) || ctx.Err() != nil):
+		for i := range results {
+			results[i].Err = fmt.Errorf("failed to create issue: %w", err)
+		}
+		return results
+
+	case err != nil:
+		// A chunk where every element failed and a missing bulk endpoint are both
+		// 4xx responses that created nothing; after any other failure the server
+		// may have created some of the issues
+		ambiguous := !isBulkRejected(err)
+		for i := range results {
+			results[i] = s.createFallback(ctx, fields[i], labels[i], ambiguous)
+		}
+		return results
+	}
+
+	failed := make(map[int]bool, len(resp.Errors))
+	for _, e := range resp.Errors {
+		failed[e.FailedElementNumber] = true
+	}
+
+	// Created issues are listed in request order, skipping the failed elements
+	created := resp.Issues
+	for i := range results {
+		if failed[i] || len(created) == 0 {
+			results[i] = s.createFallback(ctx, fields[i], labels[i], !failed[i])
+			continue
+		}
+
+		issue := created[0]
+		created = created[1:]
+		results[i].Response = &issue
+
+		// Best effort: the label is only needed while the create is in flight
+		if labels[i] != "" && !s.KeepIdempotencyLabel {
+			s.removeLabel(ctx, issue.Key, labels[i])
+		}
+	}
+	return results
+}
+
+// createFallback creates one issue of a failed bulk create on its own
+// When the bulk request may have created it, the issue is first looked up by its label,
+// and it is not created again if that lookup fails
+func (s *IssueService) createFallback(ctx context.Context, fields IssueFields, label string, ambiguous bool) BulkCreateResult {
+	if ambiguous && label != "" {
+		existing, err := s.findIssueByIdempotencyLabel(ctx, label)
+		if err != nil {
+			return BulkCreateResult{Err: fmt.Errorf("%w: could not search for label %s: %w", ErrCreateUnconfirmed, label, err)}
+		}
+		if existing != nil {
+			if !s.KeepIdempotencyLabel {
+				s.removeLabel(ctx, existing.Key, label)
+			}
+			return BulkCreateResult{Response: existing}
+		}
+	}
+
+	resp, err := s.CreateIssueWithFieldsContext(ctx, fields)
+	return BulkCreateResult{Response: resp, Err: err}
+}
+
+// isBulkRejected reports whether a bulk create failed without creating anything:
+// a 4xx response, including servers that don't have the bulk endpoint
+func isBulkRejected(err error) bool {
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		return true
+	}
+	var jiraErr *JiraError
+	return errors.As(err, &jiraErr) && jiraErr.StatusCode >= 400 && jiraErr.StatusCode < 500
+}
+
+// nonEmpty returns the non-empty strings of values
+func nonEmpty(values []string) []string {
+	var result []string
+	for _, v := range values {
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,226 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBulkServer simulates JIRA's bulk and single create endpoints
+// Issues whose summary starts with "bad" are rejected
+type fakeBulkServer struct {
+	mu            sync.Mutex
+	noBulk        bool // Respond 404 to bulk creates, like servers without the endpoint
+	failFirstBulk bool // Store the first bulk create's issues, then respond 502
+	failSearch    bool // Respond 503 to searches, so created issues can't be found
+	created       []CreateIssueRequest
+	bulkSizes     []int
+	singlePosts   int
+}
+
+func (f *fakeBulkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == "POST" && r.URL.Path == "/rest/api/2/issue/bulk":
+		if f.noBulk {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req struct {
+			IssueUpdates []CreateIssueRequest `json:"issueUpdates"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		f.bulkSizes = append(f.bulkSizes, len(req.IssueUpdates))
+
+		var issues, errs []string
+		for i, update := range req.IssueUpdates {
+			if strings.HasPrefix(update.Fields.Summary, "bad") {
+				errs = append(errs, fmt.Sprintf(`{"status":400,"failedElementNumber":%d,"elementErrors":{"errors":{"summary":"rejected"}}}`, i))
+				continue
+			}
+			issues = append(issues, fmt.Sprintf(`{"key":%q}`, f.store(update)))
+		}
+		if f.failFirstBulk && len(f.bulkSizes) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"issues":[%s],"errors":[%s]}`, strings.Join(issues, ","), strings.Join(errs, ","))
+
+	case r.Method == "POST" && r.URL.Path == "/rest/api/2/issue":
+		var req CreateIssueRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.singlePosts++
+		if strings.HasPrefix(req.Fields.Summary, "bad") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errorMessages":["summary rejected"]}`))
+			return
+		}
+		fmt.Fprintf(w, `{"key":%q}`, f.store(req))
+
+	case r.Method == "GET" && r.URL.Path == "/rest/api/2/search":
+		if f.failSearch {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		jql := r.URL.Query().Get("jql")
+		var issues []string
+		for i, req := range f.created {
+			for _, label := range req.Fields.Labels {
+				if strings.Contains(jql, label) {
+					issues = append(issues, fmt.Sprintf(`{"key":"PROJ-%d"}`, i+1))
+				}
+			}
+		}
+		fmt.Fprintf(w, `{"total":%d,"issues":[%s]}`, len(issues), strings.Join(issues, ","))
+
+	case r.Method == "PUT":
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// store records an issue and returns its key
+func (f *fakeBulkServer) store(req CreateIssueRequest) string {
+	f.created = append(f.created, req)
+	return fmt.Sprintf("PROJ-%d", len(f.created))
+}
+
+// bulkTestFields builds issue fields with the given summaries
+func bulkTestFields(summaries ...string) []IssueFields {
+	fields := make([]IssueFields, len(summaries))
+	for i, summary := range summaries {
+		fields[i] = IssueFields{Project: Project{Key: "PROJ"}, Summary: summary, IssueType: IssueType{Name: "Task"}}
+	}
+	return fields
+}
+
+func TestCreateIssuesBulkChunks(t *testing.T) {
+	fake := &fakeBulkServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	service.Idempotent = false
+
+	summaries := make([]string, 120)
+	for i := range summaries {
+		summaries[i] = fmt.Sprintf("Ticket %d", i+1)
+	}
+	results := service.CreateIssuesBulk(context.Background(), bulkTestFields(summaries...))
+
+	if fmt.Sprint(fake.bulkSizes) != "[50 50 20]" || fake.singlePosts != 0 {
+		t.Errorf("bulk requests = %v with %d single creates, want [50 50 20] and none", fake.bulkSizes, fake.singlePosts)
+	}
+	for i, result := range results {
+		want := fmt.Sprintf("PROJ-%d", i+1)
+		if result.Index != i || result.Err != nil || result.Response == nil || result.Response.Key != want {
+			t.Fatalf("results[%d] = %+v, want index %d created as %s", i, result, i, want)
+		}
+	}
+}
+
+func TestCreateIssuesBulkFallsBack(t *testing.T) {
+	tests := []struct {
+		name            string
+		noBulk          bool
+		wantSinglePosts int
+	}{
+		{"rejected elements are retried alone", false, 1},
+		{"server without bulk endpoint", true, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeBulkServer{noBulk: tt.noBulk}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+			service.Idempotent = false
+
+			results := service.CreateIssuesBulk(context.Background(), bulkTestFields("first", "bad row", "third"))
+
+			if fake.singlePosts != tt.wantSinglePosts {
+				t.Errorf("single creates = %d, want %d", fake.singlePosts, tt.wantSinglePosts)
+			}
+			if len(fake.created) != 2 {
+				t.Errorf("server stored %d issues, want 2", len(fake.created))
+			}
+			if results[0].Err != nil || results[2].Err != nil {
+				t.Errorf("results = %+v, want rows 0 and 2 created", results)
+			}
+			if results[1].Index != 1 || results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "summary rejected") {
+				t.Errorf("results[1] = %+v, want the single create's error", results[1])
+			}
+		})
+	}
+}
+
+func TestCreateIssuesBulkIdempotentRetry(t *testing.T) {
+	fake := &fakeBulkServer{failFirstBulk: true}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	service := NewIssueService(client)
+
+	results := service.CreateIssuesBulk(context.Background(), bulkTestFields("first", "second"))
+
+	if len(fake.bulkSizes) != 1 || fake.singlePosts != 0 || len(fake.created) != 2 {
+		t.Errorf("server saw %d bulk and %d single creates storing %d issues, want one bulk create and no duplicates",
+			len(fake.bulkSizes), fake.singlePosts, len(fake.created))
+	}
+	for i, result := range results {
+		want := fmt.Sprintf("PROJ-%d", i+1)
+		if result.Err != nil || result.Response == nil || result.Response.Key != want {
+			t.Errorf("results[%d] = %+v, want %s found by its label", i, result, want)
+		}
+	}
+}
+
+func TestCreateIssuesBulkLookupFails(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxAttempts int
+	}{
+		{"lookup before retrying the bulk create", 3},
+		{"lookup before a single create", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeBulkServer{failFirstBulk: true, failSearch: true}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			client := NewClient(server.URL, "user@example.com", "token")
+			client.Retry = RetryPolicy{MaxAttempts: tt.maxAttempts, BaseDelay: time.Millisecond}
+			service := NewIssueService(client)
+
+			results := service.CreateIssuesBulk(context.Background(), bulkTestFields("first", "second"))
+
+			if len(fake.bulkSizes) != 1 || fake.singlePosts != 0 {
+				t.Errorf("server saw %d bulk and %d single creates, want one bulk create and nothing posted again",
+					len(fake.bulkSizes), fake.singlePosts)
+			}
+			for i, result := range results {
+				if !errors.Is(result.Err, ErrCreateUnconfirmed) {
+					t.Errorf("results[%d].Err = %v, want ErrCreateUnconfirmed", i, result.Err)
+				}
+			}
+		})
+	}
+}
@@ -2,13 +2,12 @@ package jira
 
 import (
 	"bytes"
-	"encoding/base64"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"strconv"
 	"time"
 )
 
@@ -17,56 +16,174 @@ type Client struct {
 	BaseURL    string
 	Email      string
 	Token      string
+	Auth       Authenticator // Falls back to basic auth with Email/Token when nil
 	HTTPClient *http.Client
-	MaxRetries int
+	Retry      RetryPolicy
+	APIVersion int // REST API version: 2 (default) or 3 for Atlassian Document Format
+
+	// DescriptionFormat is the format descriptions are written in: markdown
+	// (default), wiki, or raw. See DescriptionFormatMarkdown
+	DescriptionFormat string
+
+	stats clientStats
 }
 
-// NewClient creates a new JIRA API client
+// NewClient creates a new JIRA API client using basic authentication
 func NewClient(baseURL, email, token string) *Client {
+	client := NewClientWithAuth(baseURL, &BasicAuth{Email: email, Token: token})
+	client.Email = email
+	client.Token = token
+	return client
+}
+
+// NewClientWithAuth creates a new JIRA API client using the given authenticator
+func NewClientWithAuth(baseURL string, auth Authenticator) *Client {
 	return &Client{
 		BaseURL: baseURL,
-		Email:   email,
-		Token:   token,
+		Auth:    auth,
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		MaxRetries: 3,
+		Retry: DefaultRetryPolicy(),
 	}
 }
 
 // Do performs an HTTP request with retry logic and exponential backoff
 func (c *Client) Do(method, path string, body interface{}, result interface{}) error {
-	var retryCount int
+	return c.DoContext(context.Background(), method, path, body, result)
+}
+
+// DoContext is like Do but honors ctx: cancelling it aborts the in-flight
+// request and any pending backoff, and no further retries are attempted
+func (c *Client) DoContext(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	return c.doWithRetry(ctx, method, path, body, result, nil)
+}
+
+// beforeRetryFunc runs before each retry attempt; returning done=true stops
+// retrying and makes the request return err. Supplying one marks the request
+// as safe to repeat, so transport errors are retried even for POST
+type beforeRetryFunc func(ctx context.Context) (done bool, err error)
+
+// bodyFunc is a request body built afresh for every attempt, for bodies that
+// change between retries or must not be shared with an earlier attempt
+type bodyFunc func() interface{}
+
+// doWithRetry runs the retry loop behind DoContext, calling beforeRetry (if set)
+// before every retry so callers can detect that a failed attempt actually succeeded
+func (c *Client) doWithRetry(ctx context.Context, method, path string, body interface{}, result interface{}, beforeRetry beforeRetryFunc) error {
+	policy := c.Retry
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	start := time.Now()
+	reqStats := requestStatsFrom(ctx)
+	c.stats.requests.Add(1)
+	if reqStats != nil {
+		reqStats.requests.Add(1)
+	}
+
 	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			c.stats.retries.Add(1)
+			if reqStats != nil {
+				reqStats.retries.Add(1)
+			}
+		}
+
+		attemptBody := body
+		if build, ok := body.(bodyFunc); ok {
+			attemptBody = build()
+		}
 
-	for retryCount = 0; retryCount <= c.MaxRetries; retryCount++ {
-		err := c.doRequest(method, path, body, result)
+		err := c.doRequest(ctx, method, path, attemptBody, result)
 		if err == nil {
 			return nil
 		}
 
+		// A cancelled context is never retried
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
 		// Check if error is retryable
-		if !isRetryableError(err) {
+		if !isRetryableError(isIdempotentMethod(method) || beforeRetry != nil, err) {
 			return err
 		}
 
 		lastErr = err
-		if retryCount < c.MaxRetries {
-			// Exponential backoff: 1s, 2s, 4s
-			backoff := time.Duration(1<<uint(retryCount)) * time.Second
-			time.Sleep(backoff)
+		if attempt == maxAttempts-1 {
+			break
+		}
+
+		delay := policy.backoff(attempt)
+		if policy.RespectRetryAfter {
+			if wait := retryAfter(err); wait > 0 {
+				delay = wait
+			}
+		}
+
+		if policy.Budget > 0 && time.Since(start)+delay > policy.Budget {
+			c.stats.failures.Add(1)
+			return fmt.Errorf("retry budget of %s exhausted: %w", policy.Budget, lastErr)
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+
+		if beforeRetry != nil {
+			if done, err := beforeRetry(ctx); done {
+				return err
+			}
 		}
 	}
 
+	c.stats.failures.Add(1)
+	if maxAttempts == 1 {
+		return lastErr
+	}
 	return fmt.Errorf("max retries exceeded: %w", lastErr)
 }
 
+// Stats returns a snapshot of the client's request and retry counters
+func (c *Client) Stats() ClientStats {
+	return ClientStats{
+		Requests: c.stats.requests.Load(),
+		Retries:  c.stats.retries.Load(),
+		Failures: c.stats.failures.Load(),
+	}
+}
+
+// sleepContext waits for d to elapse or ctx to be done, whichever comes first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // doRequest performs a single HTTP request
-func (c *Client) doRequest(method, path string, body interface{}, result interface{}) error {
-	url := c.BaseURL + path
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	url := c.BaseURL + c.apiPath(path)
 
 	var reqBody io.Reader
-	if body != nil {
+	contentType := "application/json"
+	multipartBody, streaming := body.(*multipartBody)
+	if streaming {
+		// Stream the files straight from disk instead of buffering them
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		contentType = multipartBody.writeTo(pw)
+		reqBody = pr
+	} else if body != nil {
 		bodyBytes, err := json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
@@ -74,25 +191,55 @@ func (c *Client) doRequest(method, path string, body interface{}, result interfa
 		reqBody = bytes.NewReader(bodyBytes)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
+	if streaming {
+		// Required by JIRA for multipart requests (XSRF protection)
+		req.Header.Set("X-Atlassian-Token", "no-check")
+	}
 
 	// Set authentication
-	auth := base64.StdEncoding.EncodeToString([]byte(c.Email + ":" + c.Token))
-	req.Header.Set("Authorization", "Basic "+auth)
+	auth := c.Auth
+	if auth == nil {
+		auth = &BasicAuth{Email: c.Email, Token: c.Token}
+	}
+	if err := auth.Authenticate(req); err != nil {
+		return err
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
 
-	resp, err := c.HTTPClient.Do(req)
+	// Uploads and downloads can legitimately outlast the client timeout, so
+	// they are bounded by ctx only
+	download, isDownload := result.(io.Writer)
+	if streaming || isDownload {
+		unbounded := *httpClient
+		unbounded.Timeout = 0
+		httpClient = &unbounded
+	}
+
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return &NetworkError{Method: method, Err: err}
 	}
 	defer resp.Body.Close()
 
+	if isDownload && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if _, err := io.Copy(download, resp.Body); err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+		return nil
+	}
+
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return fmt.Errorf("failed to read response body: %w", err)
@@ -120,11 +267,10 @@ func (c *Client) doRequest(method, path string, body interface{}, result interfa
 		case 404:
 			return &NotFoundError{Resource: "JIRA resource"}
 		case 429:
-			retryAfter := 0
-			if retryAfterStr := resp.Header.Get("Retry-After"); retryAfterStr != "" {
-				retryAfter, _ = strconv.Atoi(retryAfterStr)
-			}
+			retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
 			return &RateLimitError{RetryAfter: retryAfter, Message: jiraErr.Error()}
+		case 503:
+			jiraErr.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
 		}
 
 		return jiraErr
@@ -141,12 +287,16 @@ func (c *Client) doRequest(method, path string, body interface{}, result interfa
 }
 
 // isRetryableError checks if an error should be retried
-func isRetryableError(err error) bool {
+// Transport-level failures are only retried for idempotent requests, since
+// the server may already have processed the request
+func isRetryableError(idempotent bool, err error) bool {
 	switch e := err.(type) {
 	case *JiraError:
 		return e.IsRetryable()
 	case *RateLimitError:
 		return e.IsRetryable()
+	case *NetworkError:
+		return idempotent && isTransientNetworkError(e)
 	default:
 		return false
 	}
@@ -154,9 +304,28 @@ func isRetryableError(err error) bool {
 
 // GetIssue retrieves an issue by key
 func (c *Client) GetIssue(key string) (*Issue, error) {
+	return c.GetIssueContext(context.Background(), key)
+}
+
+// GetIssueContext retrieves an issue by key, honoring ctx cancellation
+func (c *Client) GetIssueContext(ctx context.Context, key string) (*Issue, error) {
 	var issue Issue
 	path := fmt.Sprintf("/rest/api/2/issue/%s", key)
-	if err := c.Do("GET", path, nil, &issue); err != nil {
+	if err := c.DoContext(ctx, "GET", path, nil, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// GetIssueWithOptions retrieves an issue by key, returning only the requested
+// fields and expansions (e.g. "changelog", "renderedFields", "names")
+func (c *Client) GetIssueWithOptions(ctx context.Context, key string, opts GetOptions) (*Issue, error) {
+	var issue Issue
+	path := fmt.Sprintf("/rest/api/2/issue/%s", key)
+	if params := fieldParams(opts.Fields, opts.Expand); len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+	if err := c.DoContext(ctx, "GET", path, nil, &issue); err != nil {
 		return nil, err
 	}
 	return &issue, nil
@@ -164,11 +333,33 @@ func (c *Client) GetIssue(key string) (*Issue, error) {
 
 // GetIssueByJQL retrieves issues using JQL
 func (c *Client) GetIssueByJQL(jql string, startAt, maxResults int) (*SearchResponse, error) {
+	return c.GetIssueByJQLContext(context.Background(), jql, startAt, maxResults)
+}
+
+// GetIssueByJQLContext retrieves issues using JQL, honoring ctx cancellation
+// On REST v3 the page is assembled from token-based /search/jql pages, and
+// Total is the number of issues returned
+func (c *Client) GetIssueByJQLContext(ctx context.Context, jql string, startAt, maxResults int) (*SearchResponse, error) {
+	if c.APIVersion == APIVersion3 {
+		result := SearchResponse{StartAt: startAt, MaxResults: maxResults}
+		it := c.Search(ctx, jql, SearchOptions{MaxResults: startAt + maxResults})
+		for i := 0; it.Next(); i++ {
+			if i >= startAt {
+				result.Issues = append(result.Issues, it.Issue())
+			}
+		}
+		if err := it.Err(); err != nil {
+			return nil, err
+		}
+		result.Total = len(result.Issues)
+		return &result, nil
+	}
+
 	var result SearchResponse
 	escapedJQL := url.QueryEscape(jql)
 	path := fmt.Sprintf("/rest/api/2/search?jql=%s&startAt=%d&maxResults=%d",
 		escapedJQL, startAt, maxResults)
-	if err := c.Do("GET", path, nil, &result); err != nil {
+	if err := c.DoContext(ctx, "GET", path, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -176,10 +367,15 @@ func (c *Client) GetIssueByJQL(jql string, startAt, maxResults int) (*SearchResp
 
 // GetCreateMetadata retrieves metadata for creating issues, including available issue types
 func (c *Client) GetCreateMetadata(projectKey string) (*CreateMetadata, error) {
+	return c.GetCreateMetadataContext(context.Background(), projectKey)
+}
+
+// GetCreateMetadataContext retrieves create metadata, honoring ctx cancellation
+func (c *Client) GetCreateMetadataContext(ctx context.Context, projectKey string) (*CreateMetadata, error) {
 	var result CreateMetadata
 	path := fmt.Sprintf("/rest/api/2/issue/createmeta?projectKeys=%s&expand=projects.issuetypes.fields",
 		url.QueryEscape(projectKey))
-	if err := c.Do("GET", path, nil, &result); err != nil {
+	if err := c.DoContext(ctx, "GET", path, nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
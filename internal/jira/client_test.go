@@ -0,0 +1,54 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoContextCancelStopsRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry.Jitter = false
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.DoContext(ctx, "GET", "/rest/api/2/issue/PROJ-1", nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("DoContext() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("DoContext() took %v, backoff was not interrupted", elapsed)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
+
+func TestDoContextCancelledBeforeRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.GetIssueContext(ctx, "PROJ-1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetIssueContext() error = %v, want context.Canceled", err)
+	}
+}
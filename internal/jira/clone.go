@@ -0,0 +1,180 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// cloneFields are the fields read from an issue to clone it
+var cloneFields = []string{
+	"project", "summary", "issuetype", "priority", "labels", "assignee", "components",
+	"fixVersions", "versions", "parent", "subtasks", "issuelinks",
+}
+
+// CloneOptions controls what CloneIssue copies
+type CloneOptions struct {
+	ProjectKey   string // Project to clone into; empty for the original's project
+	WithSubtasks bool   // Also clone each sub-task under the clone
+	WithLinks    bool   // Copy the original's issue links to the clone
+}
+
+// CloneResult describes a clone and its cloned sub-tasks
+type CloneResult struct {
+	Key       string
+	Summary   string
+	IssueType string
+	Parent    string
+	Links     int // Issue links copied from the original
+	Subtasks  []CloneResult
+}
+
+// CloneIssue copies an issue, linking the clone to the original with a "clones" link
+//
+// The summary, description, type, priority and labels are always copied. Components,
+// versions, assignee and parent belong to the project, so they are only copied when
+// cloning within it. When an error occurs after the clone was created, the partial
+// result is returned along with the error.
+func (s *IssueService) CloneIssue(ctx context.Context, key string, opts CloneOptions) (*CloneResult, error) {
+	return s.cloneIssue(ctx, key, opts, "")
+}
+
+// cloneIssue clones an issue, creating it under parentKey when set
+func (s *IssueService) cloneIssue(ctx context.Context, key string, opts CloneOptions, parentKey string) (*CloneResult, error) {
+	original, err := s.GetIssueWithOptions(ctx, key, GetOptions{Fields: cloneFields})
+	if err != nil {
+		return nil, err
+	}
+
+	source := original.Fields.Project.Key
+	if source == "" {
+		if source, err = ExtractProjectKey(key); err != nil {
+			return nil, err
+		}
+	}
+	target := opts.ProjectKey
+	if target == "" {
+		target = source
+	}
+	sameProject := strings.EqualFold(target, source)
+
+	fields := IssueFields{
+		Project:   Project{Key: target},
+		Summary:   original.Fields.Summary,
+		IssueType: IssueType{Name: original.Fields.IssueType.Name},
+		Labels:    original.Fields.Labels,
+	}
+	if original.Fields.Priority != nil {
+		fields.Priority = &Priority{Name: original.Fields.Priority.Name}
+	}
+	if sameProject {
+		fields.Components = original.Fields.Components
+		fields.FixVersions = versionRefs(original.Fields.FixVersions)
+		fields.Versions = versionRefs(original.Fields.Versions)
+		if original.Fields.Assignee != nil {
+			fields.Assignee = original.Fields.Assignee.Ref()
+		}
+	}
+
+	switch {
+	case parentKey != "":
+		fields.Parent = &Parent{Key: parentKey}
+	case original.Fields.IssueType.Subtask && !sameProject:
+		return nil, fmt.Errorf("cannot clone sub-task %s into project %s without its parent; clone the parent with --with-subtasks", key, target)
+	case original.Fields.Parent != nil && (sameProject || original.Fields.IssueType.Subtask):
+		fields.Parent = &Parent{Key: original.Fields.Parent.Key}
+	}
+
+	created, err := s.CreateIssueWithFieldsContext(ctx, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone %s: %w", key, err)
+	}
+
+	result := &CloneResult{
+		Key:       created.Key,
+		Summary:   fields.Summary,
+		IssueType: fields.IssueType.Name,
+	}
+	if fields.Parent != nil {
+		result.Parent = fields.Parent.Key
+	}
+
+	// The description is copied in its stored form, so no formatting is lost converting it
+	if err := s.copyDescription(ctx, key, created.Key); err != nil {
+		return result, err
+	}
+
+	linkService := NewLinkService(s.client)
+	linkType, inward, err := linkService.ResolveLinkType(ctx, "clones")
+	if err != nil {
+		return result, err
+	}
+	if err := linkService.LinkResolved(ctx, linkType, inward, created.Key, key); err != nil {
+		return result, err
+	}
+
+	if opts.WithLinks {
+		for _, link := range original.Fields.IssueLinks {
+			if link.Type.Name == linkType.Name {
+				continue
+			}
+			var err error
+			if link.OutwardIssue != nil {
+				err = linkService.LinkIssuesContext(ctx, link.Type.Name, created.Key, link.OutwardIssue.Key)
+			} else if link.InwardIssue != nil {
+				err = linkService.LinkIssuesContext(ctx, link.Type.Name, link.InwardIssue.Key, created.Key)
+			}
+			if err != nil {
+				return result, err
+			}
+			result.Links++
+		}
+	}
+
+	if opts.WithSubtasks {
+		subtaskOpts := CloneOptions{ProjectKey: target, WithLinks: opts.WithLinks}
+		for _, subtask := range original.Fields.Subtasks {
+			cloned, err := s.cloneIssue(ctx, subtask.Key, subtaskOpts, created.Key)
+			if cloned != nil {
+				result.Subtasks = append(result.Subtasks, *cloned)
+			}
+			if err != nil {
+				return result, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// copyDescription sets the description of one issue to that of another, unconverted
+func (s *IssueService) copyDescription(ctx context.Context, fromKey, toKey string) error {
+	var issue struct {
+		Fields struct {
+			Description json.RawMessage `json:"description"`
+		} `json:"fields"`
+	}
+	path := fmt.Sprintf("/rest/api/2/issue/%s?fields=description", fromKey)
+	if err := s.client.DoContext(ctx, "GET", path, nil, &issue); err != nil {
+		return fmt.Errorf("failed to get description of %s: %w", fromKey, err)
+	}
+
+	description := strings.TrimSpace(string(issue.Fields.Description))
+	if description == "" || description == "null" || description == `""` {
+		return nil
+	}
+	return s.UpdateFields(ctx, toKey, map[string]interface{}{"description": issue.Fields.Description})
+}
+
+// versionRefs reduces versions to the references sent on create
+func versionRefs(versions []Version) []Version {
+	if len(versions) == 0 {
+		return nil
+	}
+	refs := make([]Version, len(versions))
+	for i, v := range versions {
+		refs[i] = Version{ID: v.ID, Name: v.Name}
+	}
+	return refs
+}
@@ -0,0 +1,235 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCloneServer serves PROJ-1, a story with a sub-task and a link, and records
+// the issues created, links added and descriptions set
+type fakeCloneServer struct {
+	mu           sync.Mutex
+	created      []map[string]interface{}
+	links        []string // "outward --[type]--> inward"
+	descriptions map[string]string
+}
+
+func (f *fakeCloneServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/rest/api/2/issue/PROJ-1" && r.URL.Query().Get("fields") == "description":
+		w.Write([]byte(`{"key":"PROJ-1","fields":{"description":"h1. Steps"}}`))
+	case r.Method == "GET" && r.URL.Path == "/rest/api/2/issue/PROJ-1":
+		w.Write([]byte(`{"key":"PROJ-1","fields":{
+			"project":{"key":"PROJ"},"summary":"Login fails","issuetype":{"name":"Story"},
+			"priority":{"name":"High"},"labels":["auth"],"components":[{"id":"1","name":"Backend"}],
+			"assignee":{"accountId":"acc-1","displayName":"Jane"},
+			"subtasks":[{"key":"PROJ-2"}],
+			"issuelinks":[{"type":{"name":"Blocks","outward":"blocks","inward":"is blocked by"},"outwardIssue":{"key":"PROJ-9"}}]
+		}}`))
+	case r.Method == "GET" && r.URL.Path == "/rest/api/2/issue/PROJ-2":
+		w.Write([]byte(`{"key":"PROJ-2","fields":{"project":{"key":"PROJ"},"summary":"Fix token refresh","issuetype":{"name":"Sub-task","subtask":true},"parent":{"key":"PROJ-1"}}}`))
+	case r.Method == "POST" && r.URL.Path == "/rest/api/2/issue":
+		var req struct {
+			Fields map[string]interface{} `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		f.created = append(f.created, req.Fields)
+		fmt.Fprintf(w, `{"key":"OPS-%d"}`, len(f.created))
+	case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/rest/api/2/issue/OPS-"):
+		var req struct {
+			Fields struct {
+				Description string `json:"description"`
+			} `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		f.descriptions[strings.TrimPrefix(r.URL.Path, "/rest/api/2/issue/")] = req.Fields.Description
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == "GET" && r.URL.Path == "/rest/api/2/issueLinkType":
+		w.Write([]byte(`{"issueLinkTypes":[{"name":"Cloners","outward":"clones","inward":"is cloned by"}]}`))
+	case r.Method == "POST" && r.URL.Path == "/rest/api/2/issueLink":
+		var req LinkIssueRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.links = append(f.links, fmt.Sprintf("%s --[%s]--> %s", req.OutwardIssue.Key, req.Type.Name, req.InwardIssue.Key))
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestCloneIssue(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           CloneOptions
+		wantCreated    int
+		wantComponents bool
+		wantLinks      []string
+	}{
+		{
+			name:           "same project",
+			opts:           CloneOptions{},
+			wantCreated:    1,
+			wantComponents: true,
+			wantLinks:      []string{"OPS-1 --[Cloners]--> PROJ-1"},
+		},
+		{
+			name:        "other project with sub-tasks and links",
+			opts:        CloneOptions{ProjectKey: "OPS", WithSubtasks: true, WithLinks: true},
+			wantCreated: 2,
+			wantLinks: []string{
+				"OPS-1 --[Cloners]--> PROJ-1",
+				"OPS-1 --[Blocks]--> PROJ-9",
+				"OPS-2 --[Cloners]--> PROJ-2",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeCloneServer{descriptions: map[string]string{}}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+			service.Idempotent = false
+
+			result, err := service.CloneIssue(context.Background(), "PROJ-1", tt.opts)
+			if err != nil {
+				t.Fatalf("CloneIssue() error = %v", err)
+			}
+			if result.Key != "OPS-1" || result.Summary != "Login fails" {
+				t.Errorf("CloneIssue() = %+v, want OPS-1 \"Login fails\"", result)
+			}
+			if len(fake.created) != tt.wantCreated {
+				t.Fatalf("created %d issues, want %d", len(fake.created), tt.wantCreated)
+			}
+
+			clone := fake.created[0]
+			_, hasComponents := clone["components"]
+			_, hasAssignee := clone["assignee"]
+			if hasComponents != tt.wantComponents || hasAssignee != tt.wantComponents {
+				t.Errorf("clone fields = %v, want components and assignee only within the project", clone)
+			}
+			if fake.descriptions["OPS-1"] != "h1. Steps" {
+				t.Errorf("clone description = %q, want the original's unconverted", fake.descriptions["OPS-1"])
+			}
+			if fmt.Sprint(fake.links) != fmt.Sprint(tt.wantLinks) {
+				t.Errorf("links = %v, want %v", fake.links, tt.wantLinks)
+			}
+
+			if tt.opts.WithSubtasks {
+				subtask := fake.created[1]
+				if parent, _ := subtask["parent"].(map[string]interface{}); parent["key"] != "OPS-1" {
+					t.Errorf("sub-task parent = %v, want OPS-1", subtask["parent"])
+				}
+				if len(result.Subtasks) != 1 || result.Subtasks[0].Key != "OPS-2" || result.Subtasks[0].Parent != "OPS-1" {
+					t.Errorf("sub-tasks = %+v, want OPS-2 under OPS-1", result.Subtasks)
+				}
+			}
+		})
+	}
+}
+
+func TestCloneSubtaskToOtherProject(t *testing.T) {
+	server := httptest.NewServer(&fakeCloneServer{descriptions: map[string]string{}})
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	_, err := service.CloneIssue(context.Background(), "PROJ-2", CloneOptions{ProjectKey: "OPS"})
+	if err == nil || !strings.Contains(err.Error(), "without its parent") {
+		t.Errorf("CloneIssue() error = %v, want a sub-task cannot be cloned alone", err)
+	}
+}
+
+func TestMoveIssue(t *testing.T) {
+	bulkTaskPollInterval = time.Millisecond
+	defer func() { bulkTaskPollInterval = time.Second }()
+
+	tests := []struct {
+		name      string
+		noBulk    bool
+		wantKey   string
+		wantError string
+	}{
+		{"cloud", false, "OPS-12", ""},
+		{"server without bulk move", true, "", "cannot move issues through its REST API"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := 0
+			var moveReq bulkMoveRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/rest/api/2/issue/PROJ-1" && polls == 0:
+					w.Write([]byte(`{"key":"PROJ-1","fields":{"project":{"key":"PROJ"},"issuetype":{"name":"Story"}}}`))
+				case r.URL.Path == "/rest/api/2/issue/PROJ-1":
+					w.Write([]byte(`{"key":"OPS-12","fields":{"project":{"key":"OPS"}}}`))
+				case r.URL.Path == "/rest/api/2/issue/createmeta/OPS/issuetypes":
+					w.Write([]byte(`{"issueTypes":[{"id":"10001","name":"Story"}],"isLast":true}`))
+				case r.URL.Path == "/rest/api/3/bulk/issues/move" && !tt.noBulk:
+					json.NewDecoder(r.Body).Decode(&moveReq)
+					w.Write([]byte(`{"taskId":"42"}`))
+				case r.URL.Path == "/rest/api/3/bulk/queue/42":
+					polls++
+					if polls < 2 {
+						w.Write([]byte(`{"taskId":"42","status":"RUNNING"}`))
+						return
+					}
+					w.Write([]byte(`{"taskId":"42","status":"COMPLETE"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+			key, err := service.MoveIssue(context.Background(), "PROJ-1", "OPS")
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("MoveIssue() error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MoveIssue() error = %v", err)
+			}
+			if key != tt.wantKey {
+				t.Errorf("MoveIssue() = %s, want %s", key, tt.wantKey)
+			}
+			spec, ok := moveReq.TargetToSourcesMapping["OPS,10001"]
+			if !ok || fmt.Sprint(spec.IssueIdsOrKeys) != "[PROJ-1]" {
+				t.Errorf("move request = %+v, want PROJ-1 mapped to OPS,10001", moveReq)
+			}
+		})
+	}
+}
+
+func TestDeleteIssue(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/rest/api/2/issue/PROJ-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		query = r.URL.RawQuery
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	if err := service.DeleteIssue(context.Background(), "PROJ-1", true); err != nil {
+		t.Fatalf("DeleteIssue() error = %v", err)
+	}
+	if query != "deleteSubtasks=true" {
+		t.Errorf("query = %s, want deleteSubtasks=true", query)
+	}
+}
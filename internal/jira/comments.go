@@ -0,0 +1,161 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Visibility types for restricted comments
+const (
+	VisibilityGroup = "group"
+	VisibilityRole  = "role"
+)
+
+// Comment represents a comment on a JIRA issue
+type Comment struct {
+	ID           string      `json:"id"`
+	Self         string      `json:"self,omitempty"`
+	Author       *User       `json:"author,omitempty"`
+	UpdateAuthor *User       `json:"updateAuthor,omitempty"`
+	Body         string      `json:"body"`
+	Created      string      `json:"created,omitempty"`
+	Updated      string      `json:"updated,omitempty"`
+	Visibility   *Visibility `json:"visibility,omitempty"`
+}
+
+// Visibility restricts a comment to members of a group or project role
+type Visibility struct {
+	Type  string `json:"type"`  // "group" or "role"
+	Value string `json:"value"` // Group or role name
+}
+
+// CommentPage is a page of comments, as returned in the "comment" issue field
+// and by the comment endpoint
+type CommentPage struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	Comments   []Comment `json:"comments"`
+}
+
+// commentPayload is the request body for adding or editing a comment
+type commentPayload struct {
+	Body       interface{} `json:"body"`
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// ParseVisibility parses a visibility restriction written as "role:NAME" or "group:NAME"
+// An empty string or "none" means the comment is visible to everyone
+func ParseVisibility(s string) (*Visibility, error) {
+	if strings.TrimSpace(s) == "" || strings.EqualFold(strings.TrimSpace(s), "none") {
+		return nil, nil
+	}
+
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+		return nil, fmt.Errorf("invalid visibility: %s (use role:NAME or group:NAME)", s)
+	}
+
+	typ := strings.ToLower(strings.TrimSpace(parts[0]))
+	if typ != VisibilityGroup && typ != VisibilityRole {
+		return nil, fmt.Errorf("invalid visibility type: %s (expected role or group)", parts[0])
+	}
+
+	return &Visibility{Type: typ, Value: strings.TrimSpace(parts[1])}, nil
+}
+
+// UnmarshalJSON decodes a comment, converting its wiki or ADF body to Markdown
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type plainComment Comment
+	aux := struct {
+		*plainComment
+		Body json.RawMessage `json:"body"`
+	}{plainComment: (*plainComment)(c)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	body, err := decodeRichText(aux.Body)
+	if err != nil {
+		return err
+	}
+	c.Body = body
+	return nil
+}
+
+// CommentService handles JIRA issue comment operations
+type CommentService struct {
+	client *Client
+}
+
+// NewCommentService creates a new comment service
+func NewCommentService(client *Client) *CommentService {
+	return &CommentService{client: client}
+}
+
+// AddComment adds a comment to an issue, optionally restricted by visibility
+// The body is written in the client's DescriptionFormat
+func (s *CommentService) AddComment(ctx context.Context, key, body string, visibility *Visibility) (*Comment, error) {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/comment", key)
+	req := commentPayload{Body: s.client.richText(body), Visibility: visibility}
+
+	var comment Comment
+	if err := s.client.DoContext(ctx, "POST", path, req, &comment); err != nil {
+		return nil, fmt.Errorf("failed to add comment to %s: %w", key, err)
+	}
+	return &comment, nil
+}
+
+// GetComments retrieves every comment on an issue, oldest first
+func (s *CommentService) GetComments(ctx context.Context, key string) ([]Comment, error) {
+	var comments []Comment
+	for {
+		path := fmt.Sprintf("/rest/api/2/issue/%s/comment?startAt=%d&maxResults=%d", key, len(comments), DefaultSearchPageSize)
+
+		var page CommentPage
+		if err := s.client.DoContext(ctx, "GET", path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get comments for %s: %w", key, err)
+		}
+
+		comments = append(comments, page.Comments...)
+		if len(page.Comments) == 0 || len(comments) >= page.Total {
+			return comments, nil
+		}
+	}
+}
+
+// GetComment retrieves a single comment on an issue
+func (s *CommentService) GetComment(ctx context.Context, key, id string) (*Comment, error) {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/comment/%s", key, id)
+
+	var comment Comment
+	if err := s.client.DoContext(ctx, "GET", path, nil, &comment); err != nil {
+		return nil, fmt.Errorf("failed to get comment %s on %s: %w", id, key, err)
+	}
+	return &comment, nil
+}
+
+// UpdateComment replaces the body and visibility of an existing comment
+// A nil visibility makes the comment visible to everyone
+func (s *CommentService) UpdateComment(ctx context.Context, key, id, body string, visibility *Visibility) (*Comment, error) {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/comment/%s", key, id)
+	req := commentPayload{Body: s.client.richText(body), Visibility: visibility}
+
+	var comment Comment
+	if err := s.client.DoContext(ctx, "PUT", path, req, &comment); err != nil {
+		return nil, fmt.Errorf("failed to update comment %s on %s: %w", id, key, err)
+	}
+	return &comment, nil
+}
+
+// DeleteComment deletes a comment from an issue
+func (s *CommentService) DeleteComment(ctx context.Context, key, id string) error {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/comment/%s", key, id)
+	if err := s.client.DoContext(ctx, "DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete comment %s on %s: %w", id, key, err)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseVisibility(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  *Visibility
+		wantError bool
+	}{
+		{"", nil, false},
+		{"None", nil, false},
+		{"role:Developers", &Visibility{Type: VisibilityRole, Value: "Developers"}, false},
+		{"Group: jira-users ", &Visibility{Type: VisibilityGroup, Value: "jira-users"}, false},
+		{"team:Backend", nil, true},
+		{"role:", nil, true},
+		{"Developers", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseVisibility(tt.input)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("ParseVisibility(%q) error = %v, wantError %v", tt.input, err, tt.wantError)
+			}
+			if tt.expected == nil {
+				if got != nil {
+					t.Errorf("ParseVisibility(%q) = %+v, want nil", tt.input, got)
+				}
+				return
+			}
+			if got == nil || *got != *tt.expected {
+				t.Errorf("ParseVisibility(%q) = %+v, want %+v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAddComment(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion int
+		wantPath   string
+		wantADF    bool
+	}{
+		{"v2 sends wiki markup", APIVersion2, "/rest/api/2/issue/PROJ-1/comment", false},
+		{"v3 sends ADF", APIVersion3, "/rest/api/3/issue/PROJ-1/comment", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
+			var gotBody map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				data, _ := io.ReadAll(r.Body)
+				json.Unmarshal(data, &gotBody)
+				w.Write([]byte(`{"id":"10001","body":"ok"}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user@example.com", "token")
+			client.APIVersion = tt.apiVersion
+			service := NewCommentService(client)
+
+			comment, err := service.AddComment(context.Background(), "PROJ-1", "Build **passed**", &Visibility{Type: VisibilityRole, Value: "Developers"})
+			if err != nil {
+				t.Fatalf("AddComment() error = %v", err)
+			}
+
+			if comment.ID != "10001" {
+				t.Errorf("comment ID = %s, want 10001", comment.ID)
+			}
+			if gotPath != tt.wantPath {
+				t.Errorf("request path = %s, want %s", gotPath, tt.wantPath)
+			}
+			if tt.wantADF {
+				if body, ok := gotBody["body"].(map[string]interface{}); !ok || body["type"] != "doc" {
+					t.Errorf("body = %v, want an ADF doc", gotBody["body"])
+				}
+			} else if gotBody["body"] != "Build *passed*" {
+				t.Errorf("body = %v, want wiki markup", gotBody["body"])
+			}
+			visibility, _ := gotBody["visibility"].(map[string]interface{})
+			if visibility["type"] != "role" || visibility["value"] != "Developers" {
+				t.Errorf("visibility = %v", gotBody["visibility"])
+			}
+		})
+	}
+}
+
+func TestGetCommentsPaginates(t *testing.T) {
+	const total = 120
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+
+		var comments []string
+		for i := startAt; i < total && i < startAt+maxResults; i++ {
+			comments = append(comments, fmt.Sprintf(`{"id":"%d","body":"comment %d"}`, i, i))
+		}
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":%d,"total":%d,"comments":[%s]}`, startAt, maxResults, total, strings.Join(comments, ","))
+	}))
+	defer server.Close()
+
+	service := NewCommentService(NewClient(server.URL, "user@example.com", "token"))
+	comments, err := service.GetComments(context.Background(), "PROJ-1")
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+
+	if len(comments) != total {
+		t.Errorf("got %d comments, want %d", len(comments), total)
+	}
+	if requests != 3 {
+		t.Errorf("made %d requests, want 3", requests)
+	}
+	if comments[total-1].Body != "comment 119" {
+		t.Errorf("last comment = %q", comments[total-1].Body)
+	}
+}
+
+func TestGetComment(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(`{"id":"10001","body":"secret","visibility":{"type":"role","value":"Developers"}}`))
+	}))
+	defer server.Close()
+
+	service := NewCommentService(NewClient(server.URL, "user@example.com", "token"))
+	comment, err := service.GetComment(context.Background(), "PROJ-1", "10001")
+	if err != nil {
+		t.Fatalf("GetComment() error = %v", err)
+	}
+
+	if gotPath != "/rest/api/2/issue/PROJ-1/comment/10001" {
+		t.Errorf("request path = %s", gotPath)
+	}
+	if comment.Visibility == nil || *comment.Visibility != (Visibility{Type: VisibilityRole, Value: "Developers"}) {
+		t.Errorf("visibility = %+v, want role:Developers", comment.Visibility)
+	}
+}
+
+func TestCommentDecodeADFBody(t *testing.T) {
+	data := `{"id":"1","body":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"done","marks":[{"type":"strong"}]}]}]}}`
+
+	var comment Comment
+	if err := json.Unmarshal([]byte(data), &comment); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if comment.Body != "**done**" {
+		t.Errorf("Body = %q, want **done**", comment.Body)
+	}
+}
+
+func TestTransitionWithComment(t *testing.T) {
+	var gotBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &gotBody)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	err := service.TransitionIssueWithOptions(context.Background(), "PROJ-1", "31", TransitionOptions{Comment: "Deployed"})
+	if err != nil {
+		t.Fatalf("TransitionIssueWithOptions() error = %v", err)
+	}
+
+	if _, ok := gotBody["fields"]; ok {
+		t.Errorf("fields should be omitted, got %v", gotBody["fields"])
+	}
+	update, _ := gotBody["update"].(map[string]interface{})
+	comments, _ := update["comment"].([]interface{})
+	if len(comments) != 1 {
+		t.Fatalf("update = %v, want one comment", gotBody["update"])
+	}
+	add := comments[0].(map[string]interface{})["add"].(map[string]interface{})
+	if add["body"] != "Deployed" {
+		t.Errorf("comment body = %v, want Deployed", add["body"])
+	}
+}
@@ -0,0 +1,73 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// createMetaIssueTypesPage is a page of a project's creatable issue types
+// Cloud returns them in "issueTypes", Data Center in "values"
+type createMetaIssueTypesPage struct {
+	StartAt    int                       `json:"startAt"`
+	Total      int                       `json:"total"`
+	IsLast     bool                      `json:"isLast"`
+	IssueTypes []CreateMetadataIssueType `json:"issueTypes"`
+	Values     []CreateMetadataIssueType `json:"values"`
+}
+
+// createMetaFieldsPage is a page of the fields on an issue type's create screen
+// Cloud returns them in "fields", Data Center in "values"
+type createMetaFieldsPage struct {
+	StartAt int         `json:"startAt"`
+	Total   int         `json:"total"`
+	IsLast  bool        `json:"isLast"`
+	Fields  []FieldMeta `json:"fields"`
+	Values  []FieldMeta `json:"values"`
+}
+
+// GetCreateMetaIssueTypes lists the issue types that can be created in a project, without their fields
+func (c *Client) GetCreateMetaIssueTypes(ctx context.Context, projectKey string) ([]CreateMetadataIssueType, error) {
+	var issueTypes []CreateMetadataIssueType
+	for {
+		params := url.Values{"startAt": {strconv.Itoa(len(issueTypes))}}
+		path := fmt.Sprintf("/rest/api/2/issue/createmeta/%s/issuetypes?%s", url.PathEscape(projectKey), params.Encode())
+
+		var page createMetaIssueTypesPage
+		if err := c.DoContext(ctx, "GET", path, nil, &page); err != nil {
+			return nil, err
+		}
+
+		values := append(page.IssueTypes, page.Values...)
+		issueTypes = append(issueTypes, values...)
+		if page.IsLast || len(values) == 0 || (page.Total > 0 && len(issueTypes) >= page.Total) {
+			return issueTypes, nil
+		}
+	}
+}
+
+// GetCreateMetaFields lists the fields on an issue type's create screen, keyed by field ID
+func (c *Client) GetCreateMetaFields(ctx context.Context, projectKey, issueTypeID string) (map[string]FieldMeta, error) {
+	fields := make(map[string]FieldMeta)
+	count := 0
+	for {
+		params := url.Values{"startAt": {strconv.Itoa(count)}}
+		path := fmt.Sprintf("/rest/api/2/issue/createmeta/%s/issuetypes/%s?%s",
+			url.PathEscape(projectKey), url.PathEscape(issueTypeID), params.Encode())
+
+		var page createMetaFieldsPage
+		if err := c.DoContext(ctx, "GET", path, nil, &page); err != nil {
+			return nil, err
+		}
+
+		values := append(page.Fields, page.Values...)
+		for _, field := range values {
+			fields[field.FieldID] = field
+		}
+		count += len(values)
+		if page.IsLast || len(values) == 0 || (page.Total > 0 && count >= page.Total) {
+			return fields, nil
+		}
+	}
+}
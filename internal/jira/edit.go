@@ -0,0 +1,319 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Operations on a field in the update section of an edit request
+const (
+	UpdateSet    = "set"
+	UpdateAdd    = "add"
+	UpdateRemove = "remove"
+)
+
+// FieldOperation is a single operation on a field, e.g. adding one label
+type FieldOperation struct {
+	Op    string // UpdateSet, UpdateAdd or UpdateRemove
+	Value interface{}
+}
+
+// IssueUpdate is an edit of an existing issue, keyed by field ID
+//
+// Unlike IssueFields, only the fields it names are sent, so a field can be
+// cleared by setting it to nil, and multi-value fields such as labels can be
+// changed one value at a time without replacing the others. A field can be
+// set or changed by operations, but not both.
+//
+// A "description" value is text in the client's DescriptionFormat, and *User
+// values are sent as references.
+type IssueUpdate struct {
+	Fields     map[string]interface{}      // Replaced outright; nil clears the field
+	Operations map[string][]FieldOperation // Applied in order
+}
+
+// Set replaces a field's value; a nil value clears the field
+func (u *IssueUpdate) Set(field string, value interface{}) {
+	if u.Fields == nil {
+		u.Fields = make(map[string]interface{})
+	}
+	u.Fields[field] = value
+}
+
+// Add adds a value to a multi-value field
+func (u *IssueUpdate) Add(field string, value interface{}) {
+	u.operation(field, UpdateAdd, value)
+}
+
+// Remove removes a value from a multi-value field
+func (u *IssueUpdate) Remove(field string, value interface{}) {
+	u.operation(field, UpdateRemove, value)
+}
+
+func (u *IssueUpdate) operation(field, op string, value interface{}) {
+	if u.Operations == nil {
+		u.Operations = make(map[string][]FieldOperation)
+	}
+	u.Operations[field] = append(u.Operations[field], FieldOperation{Op: op, Value: value})
+}
+
+// IsEmpty reports whether the update changes nothing
+func (u IssueUpdate) IsEmpty() bool {
+	return len(u.Fields) == 0 && len(u.Operations) == 0
+}
+
+// FieldIDs returns the IDs of every field the update changes, sorted
+func (u IssueUpdate) FieldIDs() []string {
+	var ids []string
+	for id := range u.Fields {
+		ids = append(ids, id)
+	}
+	for id := range u.Operations {
+		if _, ok := u.Fields[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Validate checks that no field is both set and changed by operations, which JIRA rejects
+func (u IssueUpdate) Validate() error {
+	var conflicts []string
+	for id := range u.Operations {
+		if _, ok := u.Fields[id]; ok {
+			conflicts = append(conflicts, id)
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return fmt.Errorf("cannot both replace and add to or remove from: %s", strings.Join(conflicts, ", "))
+	}
+	return nil
+}
+
+// editPayload is the request body for editing an issue
+type editPayload struct {
+	Fields map[string]interface{}              `json:"fields,omitempty"`
+	Update map[string][]map[string]interface{} `json:"update,omitempty"`
+}
+
+// newEditPayload builds the request body for an update
+func (c *Client) newEditPayload(update IssueUpdate) editPayload {
+	var payload editPayload
+	if len(update.Fields) > 0 {
+		payload.Fields = make(map[string]interface{}, len(update.Fields))
+		for id, value := range update.Fields {
+			if text, ok := value.(string); ok && id == "description" {
+				value = c.richText(text)
+			}
+			payload.Fields[id] = editValue(value)
+		}
+	}
+	if len(update.Operations) > 0 {
+		payload.Update = make(map[string][]map[string]interface{}, len(update.Operations))
+		for id, ops := range update.Operations {
+			for _, op := range ops {
+				payload.Update[id] = append(payload.Update[id], map[string]interface{}{op.Op: editValue(op.Value)})
+			}
+		}
+	}
+	return payload
+}
+
+// editValue converts a value for sending, replacing users with references
+func editValue(value interface{}) interface{} {
+	if user, ok := value.(*User); ok && user != nil {
+		return user.Ref()
+	}
+	return value
+}
+
+// EditIssue applies an update to an existing issue, sending only the fields it changes
+func (s *IssueService) EditIssue(ctx context.Context, key string, update IssueUpdate) error {
+	if err := update.Validate(); err != nil {
+		return err
+	}
+	if update.IsEmpty() {
+		return fmt.Errorf("nothing to update on %s", key)
+	}
+
+	path := fmt.Sprintf("/rest/api/2/issue/%s", key)
+	if err := s.client.DoContext(ctx, "PUT", path, s.client.newEditPayload(update), nil); err != nil {
+		return fmt.Errorf("failed to update issue %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// FieldChange describes the value of a field before and after an update
+type FieldChange struct {
+	Field string // Field name, or ID when the name is unknown
+	Old   string
+	New   string
+}
+
+// DiffUpdate fetches the current values of the fields an update changes and
+// describes how each would change; fields that would not change are left out
+func (s *IssueService) DiffUpdate(ctx context.Context, key string, update IssueUpdate) ([]FieldChange, error) {
+	ids := update.FieldIDs()
+
+	params := url.Values{
+		"fields": {strings.Join(ids, ",")},
+		"expand": {"names"},
+	}
+	path := fmt.Sprintf("/rest/api/2/issue/%s?%s", key, params.Encode())
+
+	var resp struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+		Names  map[string]string          `json:"names"`
+	}
+	if err := s.client.DoContext(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+
+	var changes []FieldChange
+	for _, id := range ids {
+		old := describeRaw(resp.Fields[id])
+		if id == "description" {
+			old = describeRichText(resp.Fields[id])
+		}
+
+		values := old
+		if value, ok := update.Fields[id]; ok {
+			values = describeValue(value)
+			if text, ok := value.(string); ok && id == "description" {
+				// Encode the text as it would be sent, so both sides decode alike
+				encoded, _ := json.Marshal(s.client.richText(text))
+				values = describeRichText(encoded)
+			}
+		}
+		for _, op := range update.Operations[id] {
+			values = applyOperation(values, op)
+		}
+
+		change := FieldChange{
+			Field: id,
+			Old:   strings.Join(old, ", "),
+			New:   strings.Join(values, ", "),
+		}
+		if name := resp.Names[id]; name != "" {
+			change.Field = name
+		}
+		if change.Old != change.New {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// applyOperation applies an operation to the display values of a field
+func applyOperation(values []string, op FieldOperation) []string {
+	items := describeValue(op.Value)
+	switch op.Op {
+	case UpdateSet:
+		return items
+	case UpdateAdd:
+		for _, item := range items {
+			if !containsString(values, item) {
+				values = append(values, item)
+			}
+		}
+		return values
+	case UpdateRemove:
+		var kept []string
+		for _, value := range values {
+			if !containsString(items, value) {
+				kept = append(kept, value)
+			}
+		}
+		return kept
+	}
+	return values
+}
+
+// containsString reports whether values contains s, ignoring case
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeValue formats a value as it would be sent, one string per element
+func describeValue(value interface{}) []string {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return []string{fmt.Sprint(value)}
+	}
+	return describeRaw(data)
+}
+
+// describeRichText formats a rich text field as Markdown, whether it is wiki markup (v2) or ADF (v3)
+func describeRichText(data json.RawMessage) []string {
+	text, err := decodeRichText(data)
+	if err != nil {
+		return describeRaw(data)
+	}
+	if text == "" {
+		return nil
+	}
+	return []string{text}
+}
+
+// describeRaw formats a field value as returned by the API, one string per element
+// Objects are shown by their name, value or key; rich text as Markdown
+func describeRaw(data json.RawMessage) []string {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return []string{string(data)}
+	}
+
+	switch v := decoded.(type) {
+	case []interface{}:
+		var items []string
+		for _, item := range v {
+			encoded, _ := json.Marshal(item)
+			items = append(items, describeRaw(encoded)...)
+		}
+		return items
+	case map[string]interface{}:
+		if v["type"] == "doc" {
+			if text, err := decodeRichText(data); err == nil {
+				return []string{text}
+			}
+		}
+		for _, key := range []string{"displayName", "name", "value", "key", "accountId", "id"} {
+			if s, ok := v[key].(string); ok && s != "" {
+				if child, ok := v["child"].(map[string]interface{}); ok {
+					s += " > " + fmt.Sprint(child["value"])
+				}
+				return []string{s}
+			}
+		}
+		return []string{string(data)}
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
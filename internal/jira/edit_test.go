@@ -0,0 +1,189 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEditIssue(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion int
+		update     func(u *IssueUpdate)
+		wantBody   string
+		wantError  string
+	}{
+		{
+			name: "operations and cleared fields",
+			update: func(u *IssueUpdate) {
+				u.Add("labels", "frontend")
+				u.Remove("labels", "backend")
+				u.Add("components", Component{Name: "UI"})
+				u.Set("assignee", nil)
+				u.Set("description", nil)
+			},
+			wantBody: `{"fields":{"assignee":null,"description":null},"update":{"components":[{"add":{"name":"UI"}}],"labels":[{"add":"frontend"},{"remove":"backend"}]}}`,
+		},
+		{
+			name: "users sent as references",
+			update: func(u *IssueUpdate) {
+				u.Set("assignee", &User{AccountID: "acc-1", DisplayName: "Jane Doe"})
+			},
+			wantBody: `{"fields":{"assignee":{"accountId":"acc-1"}}}`,
+		},
+		{
+			name: "description converted from markdown",
+			update: func(u *IssueUpdate) {
+				u.Set("description", "**Steps**")
+			},
+			wantBody: `{"fields":{"description":"*Steps*"}}`,
+		},
+		{
+			name:       "empty description cleared on v3",
+			apiVersion: APIVersion3,
+			update: func(u *IssueUpdate) {
+				u.Set("description", "")
+			},
+			wantBody: `{"fields":{"description":null}}`,
+		},
+		{
+			name: "field both set and changed",
+			update: func(u *IssueUpdate) {
+				u.Set("labels", []string{"bug"})
+				u.Add("labels", "frontend")
+			},
+			wantError: "cannot both replace and add to or remove from: labels",
+		},
+		{
+			name:      "nothing to update",
+			update:    func(u *IssueUpdate) {},
+			wantError: "nothing to update",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "PUT" || !strings.HasSuffix(r.URL.Path, "/issue/PROJ-1") {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+				var decoded interface{}
+				json.NewDecoder(r.Body).Decode(&decoded)
+				encoded, _ := json.Marshal(decoded)
+				body = string(encoded)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "user@example.com", "token")
+			if tt.apiVersion != 0 {
+				client.APIVersion = tt.apiVersion
+			}
+
+			var update IssueUpdate
+			tt.update(&update)
+			err := NewIssueService(client).EditIssue(context.Background(), "PROJ-1", update)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("EditIssue() error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EditIssue() error = %v", err)
+			}
+			if body != tt.wantBody {
+				t.Errorf("request body = %s, want %s", body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestDiffUpdate(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte(`{"key":"PROJ-1","fields":{
+			"labels":["auth","backend"],
+			"assignee":{"accountId":"acc-2","displayName":"John Smith"},
+			"description":"h1. Steps",
+			"priority":{"name":"High"},
+			"customfield_10016":5
+		},"names":{"labels":"Labels","assignee":"Assignee","description":"Description","priority":"Priority","customfield_10016":"Story Points"}}`))
+	}))
+	defer server.Close()
+
+	var update IssueUpdate
+	update.Add("labels", "frontend")
+	update.Remove("labels", "backend")
+	update.Set("assignee", &User{AccountID: "acc-1", DisplayName: "Jane Doe"})
+	update.Set("description", nil)
+	update.Set("priority", &Priority{Name: "High"})
+	update.Set("customfield_10016", 8.0)
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	changes, err := service.DiffUpdate(context.Background(), "PROJ-1", update)
+	if err != nil {
+		t.Fatalf("DiffUpdate() error = %v", err)
+	}
+
+	if !strings.Contains(query, "fields=assignee%2Ccustomfield_10016%2Cdescription%2Clabels%2Cpriority") {
+		t.Errorf("query = %s, want only the changed fields", query)
+	}
+
+	want := []FieldChange{
+		{Field: "Assignee", Old: "John Smith", New: "Jane Doe"},
+		{Field: "Story Points", Old: "5", New: "8"},
+		{Field: "Description", Old: "# Steps", New: ""},
+		{Field: "Labels", Old: "auth, backend", New: "auth, frontend"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("DiffUpdate() = %+v, want %+v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, changes[i], want[i])
+		}
+	}
+}
+
+func TestDiffUpdateDescription(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key":"PROJ-1","fields":{"description":"Make it *bold*"}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		description string
+		want        []FieldChange
+	}{
+		{"Make it **bold**", nil},
+		{"Make it _italic_", []FieldChange{{Field: "description", Old: "Make it **bold**", New: "Make it *italic*"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var update IssueUpdate
+			update.Set("description", tt.description)
+
+			service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+			changes, err := service.DiffUpdate(context.Background(), "PROJ-1", update)
+			if err != nil {
+				t.Fatalf("DiffUpdate() error = %v", err)
+			}
+			if len(changes) != len(tt.want) {
+				t.Fatalf("DiffUpdate() = %+v, want %+v", changes, tt.want)
+			}
+			for i := range tt.want {
+				if changes[i] != tt.want[i] {
+					t.Errorf("change %d = %+v, want %+v", i, changes[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"fmt"
+	"strings"
 )
 
 // JiraError represents a JIRA API error
@@ -10,6 +11,7 @@ type JiraError struct {
 	Message       string
 	Errors        map[string]interface{}
 	ErrorMessages []string
+	RetryAfter    int // Seconds requested by a Retry-After header, if any
 }
 
 // Error implements the error interface
@@ -50,6 +52,46 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validation error in %s: %s", e.Field, e.Message)
 }
 
+// ValidationErrors collects every problem found while validating an issue
+type ValidationErrors []error
+
+// Error implements the error interface, listing one problem per line
+func (e ValidationErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	lines := make([]string, len(e))
+	for i, err := range e {
+		lines[i] = "  - " + err.Error()
+	}
+	return fmt.Sprintf("%d problems:\n%s", len(e), strings.Join(lines, "\n"))
+}
+
+// Unwrap returns the individual problems for errors.Is and errors.As
+func (e ValidationErrors) Unwrap() []error {
+	return e
+}
+
+// Add records a problem, flattening nested ValidationErrors; nil is ignored
+func (e *ValidationErrors) Add(err error) {
+	if err == nil {
+		return
+	}
+	if nested, ok := err.(ValidationErrors); ok {
+		*e = append(*e, nested...)
+		return
+	}
+	*e = append(*e, err)
+}
+
+// Err returns nil when there are no problems, and the collected problems otherwise
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
 // AuthenticationError represents an authentication failure
 type AuthenticationError struct {
 	Message string
@@ -92,3 +134,19 @@ func (e *RateLimitError) Error() string {
 func (e *RateLimitError) IsRetryable() bool {
 	return true
 }
+
+// NetworkError represents a transport-level failure before any response was received
+type NetworkError struct {
+	Method string
+	Err    error
+}
+
+// Error implements the error interface
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("request failed: %v", e.Err)
+}
+
+// Unwrap returns the underlying transport error
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,248 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Field describes an issue field as returned by the field endpoint
+type Field struct {
+	ID     string       `json:"id"`
+	Key    string       `json:"key,omitempty"`
+	Name   string       `json:"name"`
+	Custom bool         `json:"custom"`
+	Schema *FieldSchema `json:"schema,omitempty"`
+}
+
+// FieldSchema describes the type of values a field accepts
+type FieldSchema struct {
+	Type     string `json:"type"`               // e.g. "string", "number", "option", "array"
+	Items    string `json:"items,omitempty"`    // Element type of array fields
+	System   string `json:"system,omitempty"`   // Set for standard fields
+	Custom   string `json:"custom,omitempty"`   // Custom field type key, e.g. "com.atlassian.jira.plugin.system.customfieldtypes:select"
+	CustomID int    `json:"customId,omitempty"` // Numeric part of customfield_NNNNN
+}
+
+// DateFormat is the format JIRA uses for date-only fields
+const DateFormat = "2006-01-02"
+
+// FieldService resolves field names to IDs and converts values to the shape each field expects
+// The field list is fetched once and cached for the lifetime of the service
+type FieldService struct {
+	client *Client
+
+	mu     sync.Mutex
+	fields []Field
+}
+
+// NewFieldService creates a new field service
+func NewFieldService(client *Client) *FieldService {
+	return &FieldService{client: client}
+}
+
+// GetFields retrieves every system and custom field, using the cached list after the first call
+func (s *FieldService) GetFields(ctx context.Context) ([]Field, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fields != nil {
+		return s.fields, nil
+	}
+
+	var fields []Field
+	if err := s.client.DoContext(ctx, "GET", "/rest/api/2/field", nil, &fields); err != nil {
+		return nil, fmt.Errorf("failed to get fields: %w", err)
+	}
+	s.fields = fields
+	return fields, nil
+}
+
+// ResolveField finds a field by ID (e.g. customfield_10010 or duedate) or by name, ignoring case
+// A name shared by several fields is an error, since the intended one can't be known
+func (s *FieldService) ResolveField(ctx context.Context, nameOrID string) (*Field, error) {
+	fields, err := s.GetFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := strings.TrimSpace(nameOrID)
+	for i := range fields {
+		if fields[i].ID == wanted {
+			return &fields[i], nil
+		}
+	}
+
+	var matches []*Field
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, wanted) {
+			matches = append(matches, &fields[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("unknown field: %s", nameOrID)
+	case 1:
+		return matches[0], nil
+	default:
+		var ids []string
+		for _, f := range matches {
+			ids = append(ids, f.ID)
+		}
+		sort.Strings(ids)
+		return nil, fmt.Errorf("field name %q is ambiguous; use one of the IDs: %s", nameOrID, strings.Join(ids, ", "))
+	}
+}
+
+// ResolveFieldValues resolves field names to IDs and coerces each value for its field
+// The result is keyed by field ID and can be assigned to IssueFields.CustomFields
+func (s *FieldService) ResolveFieldValues(ctx context.Context, values map[string]string) (map[string]interface{}, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	resolved := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		field, err := s.ResolveField(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		coerced, err := s.CoerceValue(field, value)
+		if err != nil {
+			return nil, err
+		}
+		resolved[field.ID] = coerced
+	}
+	return resolved, nil
+}
+
+// CoerceValue converts a value given as text into the JSON shape field expects
+//
+//   - number: a JSON number
+//   - date: YYYY-MM-DD; datetime: RFC 3339, "YYYY-MM-DD HH:MM" or YYYY-MM-DD
+//   - option: {"value": ...}; cascading selects take "Parent > Child"
+//   - user: {"accountId": ...} on API v3, {"name": ...} on v2
+//   - version, component, priority: {"name": ...}
+//   - array: comma-separated elements, each converted by the item type
+//   - sprint: a sprint ID
+//
+// Values starting with { or [ that are valid JSON are sent unchanged, and an
+// empty value clears the field
+func (s *FieldService) CoerceValue(field *Field, value string) (interface{}, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return json.RawMessage("null"), nil
+	}
+	if (strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")) && json.Valid([]byte(value)) {
+		return json.RawMessage(value), nil
+	}
+	if field.Schema == nil {
+		return value, nil
+	}
+
+	// The sprint field is declared as an array but takes a single sprint ID
+	if strings.HasSuffix(field.Schema.Custom, ":gh-sprint") {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("field %s expects a sprint ID, got %q", field.Name, value)
+		}
+		return id, nil
+	}
+
+	if field.Schema.Type == "array" {
+		var items []interface{}
+		for _, part := range strings.Split(value, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			item, err := s.coerceScalar(field, field.Schema.Items, part)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	return s.coerceScalar(field, field.Schema.Type, value)
+}
+
+// coerceScalar converts a single value of the given schema type
+func (s *FieldService) coerceScalar(field *Field, schemaType, value string) (interface{}, error) {
+	switch schemaType {
+	case "number":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %s expects a number, got %q", field.Name, value)
+		}
+		return n, nil
+
+	case "date":
+		t, err := time.Parse(DateFormat, value)
+		if err != nil {
+			return nil, fmt.Errorf("field %s expects a date (YYYY-MM-DD), got %q", field.Name, value)
+		}
+		return t.Format(DateFormat), nil
+
+	case "datetime":
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04", DateFormat} {
+			if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+				return t.Format(StartedTimeFormat), nil
+			}
+		}
+		return nil, fmt.Errorf("field %s expects a date and time (YYYY-MM-DD HH:MM), got %q", field.Name, value)
+
+	case "option":
+		return map[string]string{"value": value}, nil
+
+	case "option-with-child":
+		parent, child, found := strings.Cut(value, ">")
+		option := map[string]interface{}{"value": strings.TrimSpace(parent)}
+		if found {
+			option["child"] = map[string]string{"value": strings.TrimSpace(child)}
+		}
+		return option, nil
+
+	case "user":
+		if s.client.APIVersion == APIVersion3 {
+			return map[string]string{"accountId": value}, nil
+		}
+		return map[string]string{"name": value}, nil
+
+	case "version", "component", "priority":
+		return map[string]string{"name": value}, nil
+
+	case "string":
+		if strings.HasSuffix(field.Schema.Custom, ":textarea") {
+			return s.client.richText(value), nil
+		}
+		return value, nil
+
+	default:
+		return value, nil
+	}
+}
+
+// ParseFieldAssignments parses "Name=value" pairs, as given to --field flags
+func ParseFieldAssignments(assignments []string) (map[string]string, error) {
+	if len(assignments) == 0 {
+		return nil, nil
+	}
+
+	values := make(map[string]string, len(assignments))
+	for _, assignment := range assignments {
+		name, value, found := strings.Cut(assignment, "=")
+		if !found || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid field %q (use NAME=VALUE, e.g. \"Story Points=5\")", assignment)
+		}
+		values[strings.TrimSpace(name)] = value
+	}
+	return values, nil
+}
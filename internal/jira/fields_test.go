@@ -0,0 +1,151 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testFieldsJSON = `[
+	{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string","system":"summary"}},
+	{"id":"customfield_10010","name":"Story Points","custom":true,"schema":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float","customId":10010}},
+	{"id":"customfield_10020","name":"Team","custom":true,"schema":{"type":"option","custom":"com.atlassian.jira.plugin.system.customfieldtypes:select","customId":10020}},
+	{"id":"customfield_10021","name":"Team","custom":true,"schema":{"type":"string","custom":"com.atlassian.jira.plugin.system.customfieldtypes:textfield","customId":10021}},
+	{"id":"customfield_10030","name":"Components Touched","custom":true,"schema":{"type":"array","items":"option","custom":"com.atlassian.jira.plugin.system.customfieldtypes:multiselect","customId":10030}},
+	{"id":"customfield_10040","name":"Target Date","custom":true,"schema":{"type":"date","custom":"com.atlassian.jira.plugin.system.customfieldtypes:datepicker","customId":10040}},
+	{"id":"customfield_10050","name":"Reviewer","custom":true,"schema":{"type":"user","custom":"com.atlassian.jira.plugin.system.customfieldtypes:userpicker","customId":10050}},
+	{"id":"customfield_10060","name":"Sprint","custom":true,"schema":{"type":"array","items":"json","custom":"com.pyxis.greenhopper.jira:gh-sprint","customId":10060}},
+	{"id":"customfield_10070","name":"Hardware","custom":true,"schema":{"type":"option-with-child","custom":"com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect","customId":10070}}
+]`
+
+// newFieldServer serves testFieldsJSON and counts requests to the field endpoint
+func newFieldServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/field" {
+			t.Errorf("unexpected request path %s", r.URL.Path)
+		}
+		*requests++
+		w.Write([]byte(testFieldsJSON))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestResolveFieldValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     string
+		value     string
+		wantID    string
+		wantJSON  string
+		wantError string
+	}{
+		{"number by name", "story points", "5", "customfield_10010", `5`, ""},
+		{"number by ID", "customfield_10010", "2.5", "customfield_10010", `2.5`, ""},
+		{"invalid number", "Story Points", "five", "", "", "expects a number"},
+		{"multi-select", "Components Touched", "API, UI", "customfield_10030", `[{"value":"API"},{"value":"UI"}]`, ""},
+		{"date", "Target Date", "2026-11-30", "customfield_10040", `"2026-11-30"`, ""},
+		{"invalid date", "Target Date", "30/11/2026", "", "", "expects a date"},
+		{"user on v2", "Reviewer", "jsmith", "customfield_10050", `{"name":"jsmith"}`, ""},
+		{"sprint", "Sprint", "42", "customfield_10060", `42`, ""},
+		{"cascading select", "Hardware", "Laptop > 14 inch", "customfield_10070", `{"child":{"value":"14 inch"},"value":"Laptop"}`, ""},
+		{"raw JSON", "customfield_10020", `{"id":"10100"}`, "customfield_10020", `{"id":"10100"}`, ""},
+		{"empty clears", "Story Points", "", "customfield_10010", `null`, ""},
+		{"system field", "Summary", "Hello", "summary", `"Hello"`, ""},
+		{"ambiguous name", "Team", "Payments", "", "", "customfield_10020, customfield_10021"},
+		{"unknown field", "Nope", "1", "", "", "unknown field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			server := newFieldServer(t, &requests)
+			service := NewFieldService(NewClient(server.URL, "user@example.com", "token"))
+
+			got, err := service.ResolveFieldValues(context.Background(), map[string]string{tt.field: tt.value})
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveFieldValues() error = %v", err)
+			}
+
+			data, _ := json.Marshal(got[tt.wantID])
+			if string(data) != tt.wantJSON {
+				t.Errorf("%s = %s, want %s", tt.wantID, data, tt.wantJSON)
+			}
+		})
+	}
+}
+
+func TestFieldServiceCachesFields(t *testing.T) {
+	var requests int
+	server := newFieldServer(t, &requests)
+	service := NewFieldService(NewClient(server.URL, "user@example.com", "token"))
+
+	for _, name := range []string{"Story Points", "Target Date", "Sprint"} {
+		if _, err := service.ResolveField(context.Background(), name); err != nil {
+			t.Fatalf("ResolveField(%q) error = %v", name, err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("field endpoint requested %d times, want 1", requests)
+	}
+}
+
+func TestCreateIssueSendsCustomFieldsAtTopLevel(t *testing.T) {
+	var gotBody map[string]map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &gotBody)
+		w.Write([]byte(`{"id":"1","key":"PROJ-1"}`))
+	}))
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	service.Idempotent = false
+
+	_, err := service.CreateIssueWithFields(IssueFields{
+		Summary:      "Test",
+		Description:  "Body",
+		CustomFields: map[string]interface{}{"customfield_10010": 5.0},
+	})
+	if err != nil {
+		t.Fatalf("CreateIssueWithFields() error = %v", err)
+	}
+
+	fields := gotBody["fields"]
+	if fields["customfield_10010"] != 5.0 {
+		t.Errorf("customfield_10010 = %v, want 5", fields["customfield_10010"])
+	}
+	if _, ok := fields["customfields"]; ok {
+		t.Error("custom fields should not be nested under \"customfields\"")
+	}
+	if fields["summary"] != "Test" || fields["description"] != "Body" {
+		t.Errorf("fields = %v", fields)
+	}
+}
+
+func TestParseFieldAssignments(t *testing.T) {
+	got, err := ParseFieldAssignments([]string{"Story Points=5", " Team =a=b", "Cleared="})
+	if err != nil {
+		t.Fatalf("ParseFieldAssignments() error = %v", err)
+	}
+	if got["Story Points"] != "5" || got["Team"] != "a=b" || got["Cleared"] != "" {
+		t.Errorf("ParseFieldAssignments() = %v", got)
+	}
+
+	for _, invalid := range []string{"no-equals", "=5"} {
+		if _, err := ParseFieldAssignments([]string{invalid}); err == nil {
+			t.Errorf("ParseFieldAssignments(%q) should fail", invalid)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package jira
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+)
+
+// IdempotencyLabelPrefix prefixes the label used to tag issues with their creation token
+const IdempotencyLabelPrefix = "jtc-idempotency-"
+
+// ErrCreateUnconfirmed reports that a create request failed in a way that may
+// still have created the issue, and checking for it also failed
+var ErrCreateUnconfirmed = errors.New("the issue may have been created; check JIRA before retrying")
+
+// NewIdempotencyToken generates a random client-side token for an issue create
+func NewIdempotencyToken() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate idempotency token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// idempotencyLabel returns the label carrying token
+func idempotencyLabel(token string) string {
+	return IdempotencyLabelPrefix + token
+}
+
+// findIssueByIdempotencyLabel looks up an issue already created with the given label
+// Returns nil when no such issue exists
+func (s *IssueService) findIssueByIdempotencyLabel(ctx context.Context, label string) (*CreateIssueResponse, error) {
+	query := jql.New().Where(jql.Eq("labels", jql.String(label)))
+	resp, err := s.client.GetIssueByJQLContext(ctx, query.String(), 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Issues) == 0 {
+		return nil, nil
+	}
+
+	issue := resp.Issues[0]
+	return &CreateIssueResponse{ID: issue.ID, Key: issue.Key, Self: issue.Self}, nil
+}
+
+// removeLabel removes a single label from an issue without touching the others
+func (s *IssueService) removeLabel(ctx context.Context, key, label string) error {
+	req := map[string]interface{}{
+		"update": map[string]interface{}{
+			"labels": []map[string]string{{"remove": label}},
+		},
+	}
+
+	path := fmt.Sprintf("/rest/api/2/issue/%s", key)
+	return s.client.DoContext(ctx, "PUT", path, req, nil)
+}
+
+// isLabelsFieldRejected reports whether the server refused the labels field,
+// e.g. because it is not on the create screen for the issue type
+func isLabelsFieldRejected(err error) bool {
+	var jiraErr *JiraError
+	if !errors.As(err, &jiraErr) || jiraErr.StatusCode != 400 {
+		return false
+	}
+	_, ok := jiraErr.Errors["labels"]
+	return ok
+}
+
+// hasIdempotencyLabel reports whether labels already carries a creation token
+func hasIdempotencyLabel(labels []string) bool {
+	for _, label := range labels {
+		if strings.HasPrefix(label, IdempotencyLabelPrefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,21 +1,35 @@
 package jira
 
 import (
+	"context"
+	"errors"
 	"fmt"
 )
 
 // IssueService handles JIRA issue operations
 type IssueService struct {
 	client *Client
+
+	// Idempotent tags each create with a unique label so a retried POST
+	// returns the already-created issue instead of creating a duplicate
+	Idempotent bool
+
+	// KeepIdempotencyLabel leaves the idempotency label on created issues
+	KeepIdempotencyLabel bool
 }
 
-// NewIssueService creates a new issue service
+// NewIssueService creates a new issue service with idempotent creates enabled
 func NewIssueService(client *Client) *IssueService {
-	return &IssueService{client: client}
+	return &IssueService{client: client, Idempotent: true}
 }
 
 // CreateIssue creates a new JIRA issue
 func (s *IssueService) CreateIssue(projectKey, summary, description, issueType string) (*CreateIssueResponse, error) {
+	return s.CreateIssueContext(context.Background(), projectKey, summary, description, issueType)
+}
+
+// CreateIssueContext creates a new JIRA issue, honoring ctx cancellation
+func (s *IssueService) CreateIssueContext(ctx context.Context, projectKey, summary, description, issueType string) (*CreateIssueResponse, error) {
 	fields := IssueFields{
 		Project: Project{
 			Key: projectKey,
@@ -27,26 +41,70 @@ func (s *IssueService) CreateIssue(projectKey, summary, description, issueType s
 		},
 	}
 
-	req := CreateIssueRequest{
-		Fields: fields,
+	return s.CreateIssueWithFieldsContext(ctx, fields)
+}
+
+// CreateIssueWithFields creates a new JIRA issue with full field control
+func (s *IssueService) CreateIssueWithFields(fields IssueFields) (*CreateIssueResponse, error) {
+	return s.CreateIssueWithFieldsContext(context.Background(), fields)
+}
+
+// CreateIssueWithFieldsContext creates a new JIRA issue with full field control, honoring ctx cancellation
+//
+// When Idempotent is set, the issue is tagged with a client-generated label.
+// Before a failed POST is retried, the label is looked up via JQL and, if the
+// server did create the issue, its key is returned instead of posting again.
+// The lookup relies on the search index, so an issue created moments before
+// the failure may occasionally not be found yet. If the lookup itself fails,
+// nothing is posted again and an error wrapping ErrCreateUnconfirmed is returned.
+func (s *IssueService) CreateIssueWithFieldsContext(ctx context.Context, fields IssueFields) (*CreateIssueResponse, error) {
+	if !s.Idempotent || hasIdempotencyLabel(fields.Labels) {
+		return s.createIssue(ctx, fields)
+	}
+
+	token, err := NewIdempotencyToken()
+	if err != nil {
+		return nil, err
 	}
+	label := idempotencyLabel(token)
+
+	tagged := fields
+	tagged.Labels = append(append([]string{}, fields.Labels...), label)
 
 	var resp CreateIssueResponse
-	if err := s.client.Do("POST", "/rest/api/2/issue", req, &resp); err != nil {
+	err = s.client.doWithRetry(ctx, "POST", "/rest/api/2/issue", s.client.newIssuePayload(tagged), &resp,
+		func(ctx context.Context) (bool, error) {
+			existing, lookupErr := s.findIssueByIdempotencyLabel(ctx, label)
+			if lookupErr != nil {
+				// Posting again without knowing whether the issue exists could duplicate it
+				return true, fmt.Errorf("%w: could not search for label %s: %w", ErrCreateUnconfirmed, label, lookupErr)
+			}
+			if existing == nil {
+				return false, nil
+			}
+			resp = *existing
+			return true, nil
+		})
+	if err != nil {
+		// Some create screens do not allow labels; fall back to a plain create
+		if isLabelsFieldRejected(err) && !errors.Is(err, ErrCreateUnconfirmed) {
+			return s.createIssue(ctx, fields)
+		}
 		return nil, fmt.Errorf("failed to create issue: %w", err)
 	}
 
+	// Best effort: the label is only needed while the create is in flight
+	if !s.KeepIdempotencyLabel {
+		s.removeLabel(ctx, resp.Key, label)
+	}
+
 	return &resp, nil
 }
 
-// CreateIssueWithFields creates a new JIRA issue with full field control
-func (s *IssueService) CreateIssueWithFields(fields IssueFields) (*CreateIssueResponse, error) {
-	req := CreateIssueRequest{
-		Fields: fields,
-	}
-
+// createIssue posts a create request without idempotency tagging
+func (s *IssueService) createIssue(ctx context.Context, fields IssueFields) (*CreateIssueResponse, error) {
 	var resp CreateIssueResponse
-	if err := s.client.Do("POST", "/rest/api/2/issue", req, &resp); err != nil {
+	if err := s.client.DoContext(ctx, "POST", "/rest/api/2/issue", s.client.newIssuePayload(fields), &resp); err != nil {
 		return nil, fmt.Errorf("failed to create issue: %w", err)
 	}
 
@@ -55,7 +113,21 @@ func (s *IssueService) CreateIssueWithFields(fields IssueFields) (*CreateIssueRe
 
 // GetIssue retrieves an issue by key
 func (s *IssueService) GetIssue(key string) (*Issue, error) {
-	issue, err := s.client.GetIssue(key)
+	return s.GetIssueContext(context.Background(), key)
+}
+
+// GetIssueContext retrieves an issue by key, honoring ctx cancellation
+func (s *IssueService) GetIssueContext(ctx context.Context, key string) (*Issue, error) {
+	issue, err := s.client.GetIssueContext(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+	return issue, nil
+}
+
+// GetIssueWithOptions retrieves an issue by key with field selection and expansions
+func (s *IssueService) GetIssueWithOptions(ctx context.Context, key string, opts GetOptions) (*Issue, error) {
+	issue, err := s.client.GetIssueWithOptions(ctx, key, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get issue %s: %w", key, err)
 	}
@@ -64,23 +136,53 @@ func (s *IssueService) GetIssue(key string) (*Issue, error) {
 
 // UpdateIssue updates an existing issue
 func (s *IssueService) UpdateIssue(key string, fields IssueFields) error {
-	req := UpdateIssueRequest{
-		Fields: fields,
+	return s.UpdateIssueContext(context.Background(), key, fields)
+}
+
+// UpdateIssueContext updates an existing issue, honoring ctx cancellation
+func (s *IssueService) UpdateIssueContext(ctx context.Context, key string, fields IssueFields) error {
+	path := fmt.Sprintf("/rest/api/2/issue/%s", key)
+	if err := s.client.DoContext(ctx, "PUT", path, s.client.newIssuePayload(fields), nil); err != nil {
+		return fmt.Errorf("failed to update issue %s: %w", key, err)
 	}
 
+	return nil
+}
+
+// UpdateFields sets only the given fields on an issue, keyed by field ID
+// Unlike UpdateIssueContext, no other fields are sent
+func (s *IssueService) UpdateFields(ctx context.Context, key string, fields map[string]interface{}) error {
 	path := fmt.Sprintf("/rest/api/2/issue/%s", key)
-	if err := s.client.Do("PUT", path, req, nil); err != nil {
+	body := map[string]interface{}{"fields": fields}
+	if err := s.client.DoContext(ctx, "PUT", path, body, nil); err != nil {
 		return fmt.Errorf("failed to update issue %s: %w", key, err)
 	}
 
 	return nil
 }
 
+// DeleteIssue deletes an issue
+// An issue with sub-tasks can only be deleted along with them, when deleteSubtasks is set
+func (s *IssueService) DeleteIssue(ctx context.Context, key string, deleteSubtasks bool) error {
+	path := fmt.Sprintf("/rest/api/2/issue/%s?deleteSubtasks=%t", key, deleteSubtasks)
+	if err := s.client.DoContext(ctx, "DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete issue %s: %w", key, err)
+	}
+
+	return nil
+}
+
 // GetTransitions retrieves available transitions for an issue
 func (s *IssueService) GetTransitions(key string) ([]Transition, error) {
-	path := fmt.Sprintf("/rest/api/2/issue/%s/transitions", key)
+	return s.GetTransitionsContext(context.Background(), key)
+}
+
+// GetTransitionsContext retrieves available transitions for an issue, with the fields
+// on each transition's screen, honoring ctx cancellation
+func (s *IssueService) GetTransitionsContext(ctx context.Context, key string) ([]Transition, error) {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/transitions?expand=transitions.fields", key)
 	var resp TransitionsResponse
-	if err := s.client.Do("GET", path, nil, &resp); err != nil {
+	if err := s.client.DoContext(ctx, "GET", path, nil, &resp); err != nil {
 		return nil, fmt.Errorf("failed to get transitions for %s: %w", key, err)
 	}
 	return resp.Transitions, nil
@@ -88,11 +190,47 @@ func (s *IssueService) GetTransitions(key string) ([]Transition, error) {
 
 // TransitionIssue transitions an issue to a new state
 func (s *IssueService) TransitionIssue(key, transitionID string) error {
+	return s.TransitionIssueContext(context.Background(), key, transitionID)
+}
+
+// TransitionIssueContext transitions an issue to a new state, honoring ctx cancellation
+func (s *IssueService) TransitionIssueContext(ctx context.Context, key, transitionID string) error {
+	return s.TransitionIssueWithOptions(ctx, key, transitionID, TransitionOptions{})
+}
+
+// TransitionOptions holds optional data sent along with a transition
+type TransitionOptions struct {
+	Comment           string                 // Comment added as part of the transition
+	CommentVisibility *Visibility            // Optional restriction for Comment
+	Resolution        string                 // Resolution name, e.g. "Done" or "Won't Do"
+	Fields            map[string]interface{} // Fields set on the transition screen, keyed by field ID
+}
+
+// TransitionIssueWithOptions transitions an issue to a new state, setting the resolution
+// and fields and adding a comment in the same request
+func (s *IssueService) TransitionIssueWithOptions(ctx context.Context, key, transitionID string, opts TransitionOptions) error {
 	req := TransitionRequest{}
 	req.Transition.ID = transitionID
 
+	if opts.Resolution != "" || len(opts.Fields) > 0 {
+		req.Fields = make(map[string]interface{}, len(opts.Fields)+1)
+		for id, value := range opts.Fields {
+			req.Fields[id] = value
+		}
+		if opts.Resolution != "" {
+			req.Fields["resolution"] = map[string]string{"name": opts.Resolution}
+		}
+	}
+
+	if opts.Comment != "" {
+		comment := commentPayload{Body: s.client.richText(opts.Comment), Visibility: opts.CommentVisibility}
+		req.Update = map[string]interface{}{
+			"comment": []map[string]interface{}{{"add": comment}},
+		}
+	}
+
 	path := fmt.Sprintf("/rest/api/2/issue/%s/transitions", key)
-	if err := s.client.Do("POST", path, req, nil); err != nil {
+	if err := s.client.DoContext(ctx, "POST", path, req, nil); err != nil {
 		return fmt.Errorf("failed to transition issue %s: %w", key, err)
 	}
 
@@ -101,7 +239,12 @@ func (s *IssueService) TransitionIssue(key, transitionID string) error {
 
 // SearchIssues searches for issues using JQL
 func (s *IssueService) SearchIssues(jql string, startAt, maxResults int) ([]Issue, error) {
-	resp, err := s.client.GetIssueByJQL(jql, startAt, maxResults)
+	return s.SearchIssuesContext(context.Background(), jql, startAt, maxResults)
+}
+
+// SearchIssuesContext searches for issues using JQL, honoring ctx cancellation
+func (s *IssueService) SearchIssuesContext(ctx context.Context, jql string, startAt, maxResults int) ([]Issue, error) {
+	resp, err := s.client.GetIssueByJQLContext(ctx, jql, startAt, maxResults)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search issues: %w", err)
 	}
@@ -0,0 +1,199 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeIssueServer simulates a JIRA server whose first create response is lost
+// after the issue has already been stored
+type fakeIssueServer struct {
+	mu            sync.Mutex
+	created       map[string]CreateIssueRequest
+	posts         int
+	failFirstPost bool
+	hangFirstPost time.Duration // Delay before answering the first POST, e.g. past the client timeout
+	failSearch    bool
+	removedLabels []string
+}
+
+func (f *fakeIssueServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == "POST" && r.URL.Path == "/rest/api/2/issue":
+		var req CreateIssueRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.posts++
+		key := fmt.Sprintf("PROJ-%d", len(f.created)+1)
+		f.created[key] = req
+		if f.failFirstPost && f.posts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if f.hangFirstPost > 0 && f.posts == 1 {
+			f.mu.Unlock()
+			time.Sleep(f.hangFirstPost)
+			f.mu.Lock()
+		}
+		fmt.Fprintf(w, `{"id":"1","key":%q}`, key)
+
+	case r.Method == "GET" && r.URL.Path == "/rest/api/2/search":
+		if f.failSearch {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		jql := r.URL.Query().Get("jql")
+		var issues []string
+		for key, req := range f.created {
+			for _, label := range req.Fields.Labels {
+				if strings.Contains(jql, label) {
+					issues = append(issues, fmt.Sprintf(`{"key":%q}`, key))
+				}
+			}
+		}
+		fmt.Fprintf(w, `{"total":%d,"issues":[%s]}`, len(issues), strings.Join(issues, ","))
+
+	case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/rest/api/2/issue/"):
+		var req struct {
+			Update struct {
+				Labels []map[string]string `json:"labels"`
+			} `json:"update"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		for _, op := range req.Update.Labels {
+			f.removedLabels = append(f.removedLabels, op["remove"])
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestCreateIssueWithFieldsIdempotentRetry(t *testing.T) {
+	fake := &fakeIssueServer{created: map[string]CreateIssueRequest{}, failFirstPost: true}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	service := NewIssueService(client)
+
+	resp, err := service.CreateIssueWithFields(IssueFields{
+		Project:   Project{Key: "PROJ"},
+		Summary:   "Test",
+		IssueType: IssueType{Name: "Task"},
+		Labels:    []string{"backend"},
+	})
+	if err != nil {
+		t.Fatalf("CreateIssueWithFields() error = %v", err)
+	}
+
+	if resp.Key != "PROJ-1" {
+		t.Errorf("CreateIssueWithFields() key = %s, want PROJ-1", resp.Key)
+	}
+	if fake.posts != 1 || len(fake.created) != 1 {
+		t.Errorf("server saw %d POSTs and %d issues, want exactly 1 of each", fake.posts, len(fake.created))
+	}
+
+	labels := fake.created["PROJ-1"].Fields.Labels
+	if len(labels) != 2 || labels[0] != "backend" || !strings.HasPrefix(labels[1], IdempotencyLabelPrefix) {
+		t.Errorf("created labels = %v, want [backend %s...]", labels, IdempotencyLabelPrefix)
+	}
+	if len(fake.removedLabels) != 1 || fake.removedLabels[0] != labels[1] {
+		t.Errorf("removed labels = %v, want [%s]", fake.removedLabels, labels[1])
+	}
+}
+
+func TestCreateIssueWithFieldsLookupFails(t *testing.T) {
+	fake := &fakeIssueServer{created: map[string]CreateIssueRequest{}, hangFirstPost: 200 * time.Millisecond, failSearch: true}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.HTTPClient.Timeout = 50 * time.Millisecond
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	service := NewIssueService(client)
+
+	_, err := service.CreateIssueWithFields(IssueFields{Summary: "Test"})
+	if !errors.Is(err, ErrCreateUnconfirmed) {
+		t.Fatalf("CreateIssueWithFields() error = %v, want ErrCreateUnconfirmed", err)
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.posts != 1 {
+		t.Errorf("server saw %d POSTs, want 1: a failed lookup must not post again", fake.posts)
+	}
+}
+
+func TestCreateIssueWithFieldsNotIdempotent(t *testing.T) {
+	fake := &fakeIssueServer{created: map[string]CreateIssueRequest{}, failFirstPost: true}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	service := NewIssueService(client)
+	service.Idempotent = false
+
+	if _, err := service.CreateIssueWithFields(IssueFields{Summary: "Test"}); err != nil {
+		t.Fatalf("CreateIssueWithFields() error = %v", err)
+	}
+	if len(fake.created) != 2 {
+		t.Errorf("server stored %d issues, want the duplicate that idempotency prevents", len(fake.created))
+	}
+}
+
+func TestGetIssueWithOptions(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		fmt.Fprint(w, `{
+			"key": "PROJ-1",
+			"fields": {"summary": "Fix it", "customfield_10010": 5, "customfield_10020": null},
+			"names": {"summary": "Summary", "customfield_10010": "Story Points"},
+			"changelog": {"total": 1, "histories": [{"id": "1", "items": [{"field": "status", "fromString": "To Do", "toString": "Done"}]}]}
+		}`)
+	}))
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	issue, err := service.GetIssueWithOptions(context.Background(), "PROJ-1", GetOptions{
+		Fields: []string{"summary", "customfield_10010"},
+		Expand: []string{"names", "changelog"},
+	})
+	if err != nil {
+		t.Fatalf("GetIssueWithOptions() error = %v", err)
+	}
+
+	if got := query.Get("fields"); got != "summary,customfield_10010" {
+		t.Errorf("fields = %q", got)
+	}
+	if got := query.Get("expand"); got != "names,changelog" {
+		t.Errorf("expand = %q", got)
+	}
+	if issue.Fields.CustomFields["customfield_10010"] != float64(5) {
+		t.Errorf("customfield_10010 = %v, want 5", issue.Fields.CustomFields["customfield_10010"])
+	}
+	if _, ok := issue.Fields.CustomFields["customfield_10020"]; ok {
+		t.Error("null custom field should be omitted")
+	}
+	if issue.Names["customfield_10010"] != "Story Points" {
+		t.Errorf("names = %v", issue.Names)
+	}
+	if issue.Changelog == nil || len(issue.Changelog.Histories) != 1 || issue.Changelog.Histories[0].Items[0].ToString != "Done" {
+		t.Errorf("changelog = %+v", issue.Changelog)
+	}
+}
@@ -1,6 +1,10 @@
 package jira
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"strings"
+)
 
 // LinkService handles JIRA issue linking operations
 type LinkService struct {
@@ -15,12 +19,22 @@ func NewLinkService(client *Client) *LinkService {
 // LinkBlocks creates a "Blocks" link between two issues
 // blocker blocks blocked
 func (s *LinkService) LinkBlocks(blocker, blocked string) error {
-	return s.LinkIssues("Blocks", blocker, blocked)
+	return s.LinkBlocksContext(context.Background(), blocker, blocked)
+}
+
+// LinkBlocksContext creates a "Blocks" link between two issues, honoring ctx cancellation
+func (s *LinkService) LinkBlocksContext(ctx context.Context, blocker, blocked string) error {
+	return s.LinkIssuesContext(ctx, "Blocks", blocker, blocked)
 }
 
 // LinkIssues creates a link of the specified type between two issues
 // outwardKey --[type]--> inwardKey
 func (s *LinkService) LinkIssues(linkType, outwardKey, inwardKey string) error {
+	return s.LinkIssuesContext(context.Background(), linkType, outwardKey, inwardKey)
+}
+
+// LinkIssuesContext creates a link of the specified type between two issues, honoring ctx cancellation
+func (s *LinkService) LinkIssuesContext(ctx context.Context, linkType, outwardKey, inwardKey string) error {
 	req := LinkIssueRequest{
 		Type: LinkType{
 			Name: linkType,
@@ -33,7 +47,7 @@ func (s *LinkService) LinkIssues(linkType, outwardKey, inwardKey string) error {
 		},
 	}
 
-	if err := s.client.Do("POST", "/rest/api/2/issueLink", req, nil); err != nil {
+	if err := s.client.DoContext(ctx, "POST", "/rest/api/2/issueLink", req, nil); err != nil {
 		return fmt.Errorf("failed to link issues %s --[%s]--> %s: %w",
 			outwardKey, linkType, inwardKey, err)
 	}
@@ -43,15 +57,112 @@ func (s *LinkService) LinkIssues(linkType, outwardKey, inwardKey string) error {
 
 // LinkRelates creates a "Relates" link between two issues
 func (s *LinkService) LinkRelates(fromKey, toKey string) error {
-	return s.LinkIssues("Relates", fromKey, toKey)
+	return s.LinkRelatesContext(context.Background(), fromKey, toKey)
+}
+
+// LinkRelatesContext creates a "Relates" link between two issues, honoring ctx cancellation
+func (s *LinkService) LinkRelatesContext(ctx context.Context, fromKey, toKey string) error {
+	return s.LinkIssuesContext(ctx, "Relates", fromKey, toKey)
 }
 
 // LinkDuplicates creates a "Duplicates" link between two issues
 func (s *LinkService) LinkDuplicates(fromKey, toKey string) error {
-	return s.LinkIssues("Duplicates", fromKey, toKey)
+	return s.LinkDuplicatesContext(context.Background(), fromKey, toKey)
+}
+
+// LinkDuplicatesContext creates a "Duplicates" link between two issues, honoring ctx cancellation
+func (s *LinkService) LinkDuplicatesContext(ctx context.Context, fromKey, toKey string) error {
+	return s.LinkIssuesContext(ctx, "Duplicates", fromKey, toKey)
 }
 
 // LinkClones creates a "Clones" link between two issues
 func (s *LinkService) LinkClones(fromKey, toKey string) error {
-	return s.LinkIssues("Clones", fromKey, toKey)
+	return s.LinkClonesContext(context.Background(), fromKey, toKey)
+}
+
+// LinkClonesContext creates a "Clones" link between two issues, honoring ctx cancellation
+func (s *LinkService) LinkClonesContext(ctx context.Context, fromKey, toKey string) error {
+	return s.LinkIssuesContext(ctx, "Clones", fromKey, toKey)
+}
+
+// linkTypesResponse is the response body of the issueLinkType endpoint
+type linkTypesResponse struct {
+	IssueLinkTypes []LinkType `json:"issueLinkTypes"`
+}
+
+// GetLinkTypes retrieves the link types configured on the server
+func (s *LinkService) GetLinkTypes(ctx context.Context) ([]LinkType, error) {
+	var resp linkTypesResponse
+	if err := s.client.DoContext(ctx, "GET", "/rest/api/2/issueLinkType", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get link types: %w", err)
+	}
+	return resp.IssueLinkTypes, nil
+}
+
+// MatchLinkType finds a link type by name, outward description ("blocks") or
+// inward description ("is blocked by"), ignoring case
+// inward reports whether name matched the inward description
+func MatchLinkType(types []LinkType, name string) (linkType *LinkType, inward bool, err error) {
+	wanted := strings.TrimSpace(name)
+	for i := range types {
+		if strings.EqualFold(types[i].Name, wanted) || strings.EqualFold(types[i].Outward, wanted) {
+			return &types[i], false, nil
+		}
+	}
+	for i := range types {
+		if strings.EqualFold(types[i].Inward, wanted) {
+			return &types[i], true, nil
+		}
+	}
+
+	var available []string
+	for _, t := range types {
+		available = append(available, fmt.Sprintf("%s (%s / %s)", t.Name, t.Outward, t.Inward))
+	}
+	return nil, false, fmt.Errorf("unknown link type %q; available: %s", name, strings.Join(available, ", "))
+}
+
+// ResolveLinkType looks up a link type by name or description on the server
+func (s *LinkService) ResolveLinkType(ctx context.Context, name string) (*LinkType, bool, error) {
+	types, err := s.GetLinkTypes(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return MatchLinkType(types, name)
+}
+
+// LinkResolved links fromKey to toKey using a type returned by MatchLinkType or ResolveLinkType
+// When inward is set the issues are swapped, so "PROJ-1 is blocked by PROJ-2" links PROJ-2 --[Blocks]--> PROJ-1
+func (s *LinkService) LinkResolved(ctx context.Context, linkType *LinkType, inward bool, fromKey, toKey string) error {
+	if inward {
+		return s.LinkIssuesContext(ctx, linkType.Name, toKey, fromKey)
+	}
+	return s.LinkIssuesContext(ctx, linkType.Name, fromKey, toKey)
+}
+
+// ListLinks retrieves the links on an issue
+func (s *LinkService) ListLinks(ctx context.Context, key string) ([]IssueLink, error) {
+	issue, err := s.client.GetIssueWithOptions(ctx, key, GetOptions{Fields: []string{"issuelinks"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get links for %s: %w", key, err)
+	}
+	return issue.Fields.IssueLinks, nil
+}
+
+// DeleteLink deletes an issue link by ID
+func (s *LinkService) DeleteLink(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/rest/api/2/issueLink/%s", id)
+	if err := s.client.DoContext(ctx, "DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete link %s: %w", id, err)
+	}
+	return nil
+}
+
+// Describe returns how the link reads from the issue it was listed on,
+// e.g. "is blocked by" and the linked issue
+func (l *IssueLink) Describe() (string, *Issue) {
+	if l.OutwardIssue != nil {
+		return l.Type.Outward, l.OutwardIssue
+	}
+	return l.Type.Inward, l.InwardIssue
 }
@@ -0,0 +1,145 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var testLinkTypes = []LinkType{
+	{ID: "1", Name: "Blocks", Outward: "blocks", Inward: "is blocked by"},
+	{ID: "2", Name: "Relates", Outward: "relates to", Inward: "relates to"},
+	{ID: "3", Name: "Dependency", Outward: "depends on", Inward: "is needed by"},
+}
+
+func TestMatchLinkType(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantType   string
+		wantInward bool
+		wantError  bool
+	}{
+		{"Blocks", "Blocks", false, false},
+		{"blocks", "Blocks", false, false},
+		{"is blocked by", "Blocks", true, false},
+		{"relates to", "Relates", false, false},
+		{"IS NEEDED BY", "Dependency", true, false},
+		{" depends on ", "Dependency", false, false},
+		{"Duplicates", "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			linkType, inward, err := MatchLinkType(testLinkTypes, tt.name)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("MatchLinkType(%q) error = %v, wantError %v", tt.name, err, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if linkType.Name != tt.wantType || inward != tt.wantInward {
+				t.Errorf("MatchLinkType(%q) = %s, inward %v; want %s, inward %v",
+					tt.name, linkType.Name, inward, tt.wantType, tt.wantInward)
+			}
+		})
+	}
+}
+
+func TestLinkResolvedInwardSwapsIssues(t *testing.T) {
+	var got LinkIssueRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &got)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	service := NewLinkService(NewClient(server.URL, "user@example.com", "token"))
+	if err := service.LinkResolved(context.Background(), &testLinkTypes[0], true, "PROJ-1", "PROJ-2"); err != nil {
+		t.Fatalf("LinkResolved() error = %v", err)
+	}
+
+	if got.Type.Name != "Blocks" {
+		t.Errorf("type = %s, want Blocks", got.Type.Name)
+	}
+	if got.OutwardIssue.Key != "PROJ-2" || got.InwardIssue.Key != "PROJ-1" {
+		t.Errorf("outward = %s, inward = %s; want PROJ-2, PROJ-1", got.OutwardIssue.Key, got.InwardIssue.Key)
+	}
+}
+
+func TestListLinks(t *testing.T) {
+	var gotPath, gotFields string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotFields = r.URL.Query().Get("fields")
+		w.Write([]byte(`{"key":"PROJ-1","fields":{"issuelinks":[
+			{"id":"10","type":{"name":"Blocks","inward":"is blocked by","outward":"blocks"},
+			 "inwardIssue":{"key":"PROJ-2","fields":{"summary":"Migration","status":{"name":"Done"}}}},
+			{"id":"11","type":{"name":"Relates","inward":"relates to","outward":"relates to"},
+			 "outwardIssue":{"key":"PROJ-3","fields":{"summary":"Docs"}}}
+		]}}`))
+	}))
+	defer server.Close()
+
+	service := NewLinkService(NewClient(server.URL, "user@example.com", "token"))
+	links, err := service.ListLinks(context.Background(), "PROJ-1")
+	if err != nil {
+		t.Fatalf("ListLinks() error = %v", err)
+	}
+
+	if gotPath != "/rest/api/2/issue/PROJ-1" || gotFields != "issuelinks" {
+		t.Errorf("request = %s?fields=%s", gotPath, gotFields)
+	}
+	if len(links) != 2 {
+		t.Fatalf("got %d links, want 2", len(links))
+	}
+
+	description, issue := links[0].Describe()
+	if links[0].ID != "10" || description != "is blocked by" || issue.Key != "PROJ-2" {
+		t.Errorf("links[0] = %s %s %s", links[0].ID, description, issue.Key)
+	}
+	if issue.Fields.Status == nil || issue.Fields.Status.Name != "Done" {
+		t.Errorf("links[0] status = %v", issue.Fields.Status)
+	}
+
+	description, issue = links[1].Describe()
+	if description != "relates to" || issue.Key != "PROJ-3" {
+		t.Errorf("links[1] = %s %s", description, issue.Key)
+	}
+}
+
+func TestGetLinkTypesAndDeleteLink(t *testing.T) {
+	var gotMethod, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(linkTypesResponse{IssueLinkTypes: testLinkTypes})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service := NewLinkService(NewClient(server.URL, "user@example.com", "token"))
+
+	linkType, inward, err := service.ResolveLinkType(context.Background(), "depends on")
+	if err != nil {
+		t.Fatalf("ResolveLinkType() error = %v", err)
+	}
+	if gotPath != "/rest/api/2/issueLinkType" {
+		t.Errorf("request path = %s", gotPath)
+	}
+	if linkType.Name != "Dependency" || inward {
+		t.Errorf("ResolveLinkType() = %s, inward %v", linkType.Name, inward)
+	}
+
+	if err := service.DeleteLink(context.Background(), "10"); err != nil {
+		t.Fatalf("DeleteLink() error = %v", err)
+	}
+	if gotMethod != "DELETE" || gotPath != "/rest/api/2/issueLink/10" {
+		t.Errorf("request = %s %s", gotMethod, gotPath)
+	}
+}
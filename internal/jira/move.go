@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// bulkTaskPollInterval is how often a running bulk move task is checked
+var bulkTaskPollInterval = time.Second
+
+// bulkMoveSpec moves the listed issues to the target project and issue type, letting
+// JIRA map statuses, fields and sub-task types to the target's defaults
+type bulkMoveSpec struct {
+	InferClassificationDefaults bool     `json:"inferClassificationDefaults"`
+	InferFieldDefaults          bool     `json:"inferFieldDefaults"`
+	InferStatusDefaults         bool     `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault     bool     `json:"inferSubtaskTypeDefault"`
+	IssueIdsOrKeys              []string `json:"issueIdsOrKeys"`
+}
+
+// bulkMoveRequest is the request body of the bulk move endpoint
+type bulkMoveRequest struct {
+	SendBulkNotification   bool                    `json:"sendBulkNotification"`
+	TargetToSourcesMapping map[string]bulkMoveSpec `json:"targetToSourcesMapping"` // Keyed by "PROJECT,issueTypeID"
+}
+
+// bulkTask is the state of an asynchronous bulk operation
+type bulkTask struct {
+	TaskID                 string              `json:"taskId"`
+	Status                 string              `json:"status"`
+	ProgressPercent        int                 `json:"progressPercent"`
+	FailedAccessibleIssues map[string][]string `json:"failedAccessibleIssues"` // Keyed by issue ID
+}
+
+// MoveIssue moves an issue and its sub-tasks to another project, keeping its issue
+// type, and returns the issue's new key
+//
+// Moving uses Jira Cloud's bulk move operation; Server and Data Center have no REST
+// API for it, which is reported as an error.
+func (s *IssueService) MoveIssue(ctx context.Context, key, projectKey string) (string, error) {
+	issue, err := s.GetIssueWithOptions(ctx, key, GetOptions{Fields: []string{"project", "issuetype"}})
+	if err != nil {
+		return "", err
+	}
+	if strings.EqualFold(issue.Fields.Project.Key, projectKey) {
+		return "", fmt.Errorf("%s is already in project %s", key, projectKey)
+	}
+	if issue.Fields.IssueType.Subtask {
+		return "", fmt.Errorf("cannot move sub-task %s on its own; move its parent instead", key)
+	}
+
+	issueTypes, err := s.client.GetCreateMetaIssueTypes(ctx, projectKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to get issue types for project %s: %w", projectKey, err)
+	}
+	var issueTypeID string
+	var names []string
+	for _, issueType := range issueTypes {
+		if strings.EqualFold(issueType.Name, issue.Fields.IssueType.Name) {
+			issueTypeID = issueType.ID
+		}
+		names = append(names, issueType.Name)
+	}
+	if issueTypeID == "" {
+		return "", fmt.Errorf("project %s has no %s issue type (available: %s)", projectKey, issue.Fields.IssueType.Name, strings.Join(names, ", "))
+	}
+
+	req := bulkMoveRequest{
+		SendBulkNotification: true,
+		TargetToSourcesMapping: map[string]bulkMoveSpec{
+			projectKey + "," + issueTypeID: {
+				InferClassificationDefaults: true,
+				InferFieldDefaults:          true,
+				InferStatusDefaults:         true,
+				InferSubtaskTypeDefault:     true,
+				IssueIdsOrKeys:              []string{key},
+			},
+		},
+	}
+
+	var task bulkTask
+	err = s.client.DoContext(ctx, "POST", "/rest/api/3/bulk/issues/move", req, &task)
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		return "", fmt.Errorf("this JIRA server cannot move issues through its REST API; clone %s into %s and delete it instead", key, projectKey)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to move %s to %s: %w", key, projectKey, err)
+	}
+
+	if err := s.waitForBulkTask(ctx, task.TaskID); err != nil {
+		return "", fmt.Errorf("failed to move %s to %s: %w", key, projectKey, err)
+	}
+
+	// The old key redirects to the moved issue
+	moved, err := s.GetIssueWithOptions(ctx, key, GetOptions{Fields: []string{"project"}})
+	if err != nil {
+		return "", err
+	}
+	return moved.Key, nil
+}
+
+// waitForBulkTask polls a bulk operation until it finishes
+func (s *IssueService) waitForBulkTask(ctx context.Context, taskID string) error {
+	path := fmt.Sprintf("/rest/api/3/bulk/queue/%s", taskID)
+	for {
+		var task bulkTask
+		if err := s.client.DoContext(ctx, "GET", path, nil, &task); err != nil {
+			return fmt.Errorf("failed to check bulk task %s: %w", taskID, err)
+		}
+
+		switch task.Status {
+		case "COMPLETE":
+			if len(task.FailedAccessibleIssues) == 0 {
+				return nil
+			}
+			var problems []string
+			for _, id := range sortedFieldIDs(task.FailedAccessibleIssues) {
+				problems = append(problems, task.FailedAccessibleIssues[id]...)
+			}
+			return errors.New(strings.Join(problems, "; "))
+		case "FAILED", "CANCELLED", "CANCEL_REQUESTED", "DEAD":
+			return fmt.Errorf("bulk task %s ended with status %s", taskID, task.Status)
+		}
+
+		if err := sleepContext(ctx, bulkTaskPollInterval); err != nil {
+			return err
+		}
+	}
+}
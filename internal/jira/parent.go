@@ -0,0 +1,114 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ParentService works out how to make one issue the parent of another
+//
+// Sub-tasks, and every child on API v3, use the "parent" field. On API v2 epic
+// children use the Epic Link custom field when the server has one, since older
+// Server and Data Center instances don't accept "parent" for epics
+type ParentService struct {
+	client *Client
+	fields *FieldService
+
+	mu      sync.Mutex
+	parents map[string]*Issue // Parent issue types, by key
+}
+
+// NewParentService creates a new parent service
+func NewParentService(client *Client) *ParentService {
+	return &ParentService{
+		client:  client,
+		fields:  NewFieldService(client),
+		parents: make(map[string]*Issue),
+	}
+}
+
+// IsSubtaskType reports whether an issue type name is a sub-task type
+func IsSubtaskType(name string) bool {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.ReplaceAll(name, "-", ""), " ", ""))
+	return normalized == "subtask"
+}
+
+// IsEpicType reports whether an issue type name is the epic type
+func IsEpicType(name string) bool {
+	return strings.EqualFold(strings.TrimSpace(name), "Epic")
+}
+
+// getParent fetches the parent issue's type, caching it by key
+func (s *ParentService) getParent(ctx context.Context, key string) (*Issue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if issue, ok := s.parents[key]; ok {
+		return issue, nil
+	}
+
+	issue, err := s.client.GetIssueWithOptions(ctx, key, GetOptions{Fields: []string{"issuetype"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent %s: %w", key, err)
+	}
+	s.parents[key] = issue
+	return issue, nil
+}
+
+// epicLinkField returns the ID of the Epic Link custom field, or "" when the server has none
+func (s *ParentService) epicLinkField(ctx context.Context) (string, error) {
+	fields, err := s.fields.GetFields(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range fields {
+		if f.Schema != nil && strings.HasSuffix(f.Schema.Custom, ":gh-epic-link") {
+			return f.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// ParentFields returns the fields, keyed by field ID, that make an issue a child of parentKey
+// subtask reports whether the child is a sub-task, which always uses the parent field
+// Whether the hierarchy is allowed (e.g. a sub-task under an epic) is left to the server
+func (s *ParentService) ParentFields(ctx context.Context, parentKey string, subtask bool) (map[string]interface{}, error) {
+	parentField := map[string]interface{}{"parent": Parent{Key: parentKey}}
+	if subtask || s.client.APIVersion == APIVersion3 {
+		return parentField, nil
+	}
+
+	// A standard issue under an epic: use Epic Link where the server still has it
+	parent, err := s.getParent(ctx, parentKey)
+	if err != nil {
+		return nil, err
+	}
+	if !IsEpicType(parent.Fields.IssueType.Name) {
+		return parentField, nil
+	}
+
+	epicLink, err := s.epicLinkField(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if epicLink == "" {
+		return parentField, nil
+	}
+	return map[string]interface{}{epicLink: parentKey}, nil
+}
+
+// SetParent makes an existing issue a child of parentKey
+func (s *ParentService) SetParent(ctx context.Context, key, parentKey string) error {
+	issue, err := s.client.GetIssueWithOptions(ctx, key, GetOptions{Fields: []string{"issuetype"}})
+	if err != nil {
+		return fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+
+	fields, err := s.ParentFields(ctx, parentKey, issue.Fields.IssueType.Subtask || IsSubtaskType(issue.Fields.IssueType.Name))
+	if err != nil {
+		return err
+	}
+	return NewIssueService(s.client).UpdateFields(ctx, key, fields)
+}
@@ -0,0 +1,114 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newParentServer serves an epic PROJ-1, a story PROJ-2 and a field list that
+// includes an Epic Link field when epicLink is set
+func newParentServer(t *testing.T, epicLink bool, updates map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/api/2/field":
+			if epicLink {
+				w.Write([]byte(`[{"id":"customfield_10008","name":"Epic Link","custom":true,"schema":{"type":"any","custom":"com.pyxis.greenhopper.jira:gh-epic-link"}}]`))
+				return
+			}
+			w.Write([]byte(`[]`))
+		case r.Method == "PUT":
+			data, _ := io.ReadAll(r.Body)
+			updates[r.URL.Path] = string(data)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/rest/api/2/issue/PROJ-1" || r.URL.Path == "/rest/api/3/issue/PROJ-1":
+			w.Write([]byte(`{"key":"PROJ-1","fields":{"issuetype":{"name":"Epic"}}}`))
+		case r.URL.Path == "/rest/api/2/issue/PROJ-2" || r.URL.Path == "/rest/api/3/issue/PROJ-2":
+			w.Write([]byte(`{"key":"PROJ-2","fields":{"issuetype":{"name":"Story"}}}`))
+		case r.URL.Path == "/rest/api/2/issue/PROJ-3":
+			w.Write([]byte(`{"key":"PROJ-3","fields":{"issuetype":{"name":"Sub-task","subtask":true}}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestParentFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion int
+		epicLink   bool
+		parent     string
+		subtask    bool
+		expected   string
+	}{
+		{"epic child on v3", APIVersion3, true, "PROJ-1", false, `{"parent":{"key":"PROJ-1"}}`},
+		{"epic child with Epic Link", APIVersion2, true, "PROJ-1", false, `{"customfield_10008":"PROJ-1"}`},
+		{"epic child without Epic Link", APIVersion2, false, "PROJ-1", false, `{"parent":{"key":"PROJ-1"}}`},
+		{"sub-task", APIVersion2, true, "PROJ-2", true, `{"parent":{"key":"PROJ-2"}}`},
+		{"non-epic parent", APIVersion2, true, "PROJ-2", false, `{"parent":{"key":"PROJ-2"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newParentServer(t, tt.epicLink, map[string]string{})
+			client := NewClient(server.URL, "user@example.com", "token")
+			client.APIVersion = tt.apiVersion
+
+			fields, err := NewParentService(client).ParentFields(context.Background(), tt.parent, tt.subtask)
+			if err != nil {
+				t.Fatalf("ParentFields() error = %v", err)
+			}
+			data, _ := json.Marshal(fields)
+			if string(data) != tt.expected {
+				t.Errorf("ParentFields() = %s, want %s", data, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetParent(t *testing.T) {
+	updates := make(map[string]string)
+	server := newParentServer(t, true, updates)
+	service := NewParentService(NewClient(server.URL, "user@example.com", "token"))
+
+	if err := service.SetParent(context.Background(), "PROJ-2", "PROJ-1"); err != nil {
+		t.Fatalf("SetParent(story) error = %v", err)
+	}
+	if err := service.SetParent(context.Background(), "PROJ-3", "PROJ-2"); err != nil {
+		t.Fatalf("SetParent(sub-task) error = %v", err)
+	}
+
+	expected := map[string]string{
+		"/rest/api/2/issue/PROJ-2": `{"fields":{"customfield_10008":"PROJ-1"}}`,
+		"/rest/api/2/issue/PROJ-3": `{"fields":{"parent":{"key":"PROJ-2"}}}`,
+	}
+	for path, want := range expected {
+		if updates[path] != want {
+			t.Errorf("PUT %s = %s, want %s", path, updates[path], want)
+		}
+	}
+}
+
+func TestIsSubtaskType(t *testing.T) {
+	tests := map[string]bool{
+		"Sub-task": true,
+		"Subtask":  true,
+		"sub task": true,
+		"Task":     false,
+		"Story":    false,
+	}
+
+	for name, expected := range tests {
+		if got := IsSubtaskType(name); got != expected {
+			t.Errorf("IsSubtaskType(%q) = %v, want %v", name, got, expected)
+		}
+	}
+}
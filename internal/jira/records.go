@@ -0,0 +1,54 @@
+package jira
+
+import (
+	"strings"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+)
+
+// FieldValues returns the values of a field by its JQL name, so tracked tickets
+// can be filtered offline with jql.Filter. Names ignore case and accept the
+// usual JIRA aliases, e.g. issuetype or type, reporter or creator, duedate or due.
+func (r TicketRecord) FieldValues(field string) ([]string, bool) {
+	switch strings.ToLower(field) {
+	case "key", "issuekey", "issue":
+		return singleValue(r.Key), true
+	case "summary", "text":
+		return singleValue(r.Summary), true
+	case "status":
+		return singleValue(r.Status), true
+	case "assignee":
+		return singleValue(r.Assignee), true
+	case "creator", "reporter":
+		return singleValue(r.Creator), true
+	case "priority":
+		return singleValue(r.Priority), true
+	case "type", "issuetype":
+		return singleValue(r.IssueType), true
+	case "project":
+		return singleValue(r.Project), true
+	case "parent":
+		return singleValue(r.Parent), true
+	case "blockedby", "blocked_by":
+		return r.BlockedBy, true
+	case "created", "createddate":
+		if r.CreatedAt.IsZero() {
+			return nil, true
+		}
+		return singleValue(r.CreatedAt.Local().Format(jql.DateTimeLayout)), true
+	case "due", "duedate", "estimated_end":
+		if r.EstimatedEndDate == nil {
+			return nil, true
+		}
+		return singleValue(r.EstimatedEndDate.Local().Format(jql.DateTimeLayout)), true
+	}
+	return nil, false
+}
+
+// singleValue returns s as a single value, or none when it is empty
+func singleValue(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
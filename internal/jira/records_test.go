@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+)
+
+func TestTicketRecordFilter(t *testing.T) {
+	due := time.Date(2024, 3, 15, 17, 0, 0, 0, time.Local)
+	records := []TicketRecord{
+		{Key: "PROJ-1", Summary: "Epic", Status: "In Progress", IssueType: "Epic", Priority: "High", Creator: "jane@example.com", Project: "backend"},
+		{Key: "PROJ-2", Summary: "Login API", Status: "To Do", IssueType: "Story", Priority: "Medium", Assignee: "john@example.com", Parent: "PROJ-1", BlockedBy: []string{"PROJ-3"}, EstimatedEndDate: &due, Project: "backend"},
+		{Key: "PROJ-3", Summary: "Schema", Status: "Done", IssueType: "Task", Priority: "Highest", Assignee: "jane@example.com", CreatedAt: time.Date(2024, 3, 1, 9, 0, 0, 0, time.Local)},
+	}
+
+	tests := []struct {
+		query     string
+		want      string
+		wantError string
+	}{
+		{query: `status != Done AND assignee in (john@example.com, jane@example.com) ORDER BY priority`, want: "PROJ-2"},
+		{query: `issuetype != Epic ORDER BY priority`, want: "PROJ-3 PROJ-2"},
+		{query: `reporter = jane@example.com OR blockedBy = PROJ-3`, want: "PROJ-1 PROJ-2"},
+		{query: `parent = PROJ-1 AND duedate < 2024-03-16`, want: "PROJ-2"},
+		{query: `created IS EMPTY AND project = BACKEND ORDER BY key DESC`, want: "PROJ-2 PROJ-1"},
+		{query: `sprint = 5`, wantError: "unknown field: sprint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			stmt, err := jql.Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			matched, err := jql.Filter(stmt, records, jql.Env{})
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("Filter() error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Filter() error = %v", err)
+			}
+
+			keys := make([]string, len(matched))
+			for i, r := range matched {
+				keys[i] = r.Key
+			}
+			if got := strings.Join(keys, " "); got != tt.want {
+				t.Errorf("Filter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
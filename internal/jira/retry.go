@@ -0,0 +1,152 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how Client retries failed requests
+type RetryPolicy struct {
+	MaxAttempts       int           // Total attempts per request, including the first
+	BaseDelay         time.Duration // Delay before the first retry; doubles on each retry
+	MaxDelay          time.Duration // Upper bound for a single backoff delay
+	Budget            time.Duration // Total time allowed for a request and all retries (0 = unlimited)
+	Jitter            bool          // Use full jitter: a random delay between 0 and the backoff
+	RespectRetryAfter bool          // Wait as long as the server's Retry-After header asks
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewClient
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       4,
+		BaseDelay:         1 * time.Second,
+		MaxDelay:          30 * time.Second,
+		Budget:            2 * time.Minute,
+		Jitter:            true,
+		RespectRetryAfter: true,
+	}
+}
+
+// backoff returns the delay before retry number retry (0-based)
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 0; i < retry && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter && delay > 0 {
+		delay = time.Duration(rand.Int63n(int64(delay) + 1))
+	}
+	return delay
+}
+
+// retryAfter returns the delay requested by the server, if any
+func retryAfter(err error) time.Duration {
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) && rateErr.RetryAfter > 0 {
+		return time.Duration(rateErr.RetryAfter) * time.Second
+	}
+	var jiraErr *JiraError
+	if errors.As(err, &jiraErr) && jiraErr.RetryAfter > 0 {
+		return time.Duration(jiraErr.RetryAfter) * time.Second
+	}
+	return 0
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) int {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return seconds
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if seconds := int(time.Until(date).Round(time.Second).Seconds()); seconds > 0 {
+			return seconds
+		}
+	}
+	return 0
+}
+
+// isIdempotentMethod reports whether repeating a request with this method is safe
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
+
+// isTransientNetworkError checks if a transport-level error is likely to succeed on retry
+func isTransientNetworkError(err error) bool {
+	var netErr *NetworkError
+	if !errors.As(err, &netErr) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var timeoutErr net.Error
+	return errors.As(err, &timeoutErr) && timeoutErr.Timeout()
+}
+
+// ClientStats summarizes request and retry activity for a Client
+type ClientStats struct {
+	Requests int64 // Logical requests made through Do/DoContext
+	Retries  int64 // Retry attempts across all requests
+	Failures int64 // Requests that failed after exhausting retries
+}
+
+// clientStats holds the live counters behind ClientStats
+type clientStats struct {
+	requests atomic.Int64
+	retries  atomic.Int64
+	failures atomic.Int64
+}
+
+// RequestStats accumulates retry counts for the requests made with a context
+// Attach it with WithRequestStats to attribute retries to a unit of work
+type RequestStats struct {
+	requests atomic.Int64
+	retries  atomic.Int64
+}
+
+// Requests returns the number of requests recorded
+func (s *RequestStats) Requests() int {
+	return int(s.requests.Load())
+}
+
+// Retries returns the number of retries recorded
+func (s *RequestStats) Retries() int {
+	return int(s.retries.Load())
+}
+
+// requestStatsKey is the context key for RequestStats
+type requestStatsKey struct{}
+
+// WithRequestStats returns a context that records retry counts into stats
+func WithRequestStats(ctx context.Context, stats *RequestStats) context.Context {
+	return context.WithValue(ctx, requestStatsKey{}, stats)
+}
+
+// requestStatsFrom returns the RequestStats attached to ctx, if any
+func requestStatsFrom(ctx context.Context) *RequestStats {
+	stats, _ := ctx.Value(requestStatsKey{}).(*RequestStats)
+	return stats
+}
@@ -0,0 +1,171 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastRetryPolicy keeps retry tests quick and deterministic
+func fastRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       3,
+		BaseDelay:         time.Millisecond,
+		MaxDelay:          5 * time.Millisecond,
+		RespectRetryAfter: true,
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	tests := []struct {
+		retry    int
+		expected time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{3, 5 * time.Second},
+		{10, 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := policy.backoff(tt.retry); got != tt.expected {
+			t.Errorf("backoff(%d) = %v, want %v", tt.retry, got, tt.expected)
+		}
+	}
+
+	policy.Jitter = true
+	for i := 0; i < 100; i++ {
+		if got := policy.backoff(2); got < 0 || got > 4*time.Second {
+			t.Fatalf("backoff(2) with jitter = %v, want within [0, 4s]", got)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if got := parseRetryAfter("7"); got != 7 {
+		t.Errorf("parseRetryAfter(\"7\") = %d, want 7", got)
+	}
+	if got := parseRetryAfter(""); got != 0 {
+		t.Errorf("parseRetryAfter(\"\") = %d, want 0", got)
+	}
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if got := parseRetryAfter(date); got < 8 || got > 11 {
+		t.Errorf("parseRetryAfter(date) = %d, want ~10", got)
+	}
+}
+
+func TestDoContextHonorsRetryAfter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry = fastRetryPolicy()
+
+	start := time.Now()
+	if err := client.Do("GET", "/rest/api/2/myself", nil, nil); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Do() retried after %v, want at least the 1s Retry-After", elapsed)
+	}
+	if stats := client.Stats(); stats.Requests != 1 || stats.Retries != 1 {
+		t.Errorf("Stats() = %+v, want 1 request and 1 retry", stats)
+	}
+}
+
+func TestDoContextRetryBudget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry = fastRetryPolicy()
+	client.Retry.Budget = time.Second
+
+	err := client.Do("GET", "/rest/api/2/myself", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "budget") {
+		t.Fatalf("Do() error = %v, want retry budget error", err)
+	}
+	var rateErr *RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Errorf("Do() error should wrap the RateLimitError, got %T", err)
+	}
+}
+
+func TestDoContextNetworkErrorsIdempotentOnly(t *testing.T) {
+	// Grab a free port and close it so connections are refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	tests := []struct {
+		method      string
+		wantRetries int64
+	}{
+		{"GET", 2},
+		{"PUT", 2},
+		{"POST", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			client := NewClient("http://"+addr, "user@example.com", "token")
+			client.Retry = fastRetryPolicy()
+
+			err := client.Do(tt.method, "/rest/api/2/issue", nil, nil)
+			var netErr *NetworkError
+			if !errors.As(err, &netErr) {
+				t.Fatalf("Do() error = %v, want NetworkError", err)
+			}
+			if got := client.Stats().Retries; got != tt.wantRetries {
+				t.Errorf("retries = %d, want %d", got, tt.wantRetries)
+			}
+		})
+	}
+}
+
+func TestWithRequestStats(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.Retry = fastRetryPolicy()
+
+	var stats RequestStats
+	ctx := WithRequestStats(context.Background(), &stats)
+	if err := client.DoContext(ctx, "GET", "/rest/api/2/myself", nil, nil); err != nil {
+		t.Fatalf("DoContext() error = %v", err)
+	}
+	if stats.Requests() != 1 || stats.Retries() != 2 {
+		t.Errorf("RequestStats = %d requests / %d retries, want 1 / 2", stats.Requests(), stats.Retries())
+	}
+}
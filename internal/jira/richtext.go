@@ -0,0 +1,211 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/adf"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/wiki"
+)
+
+// Supported REST API versions
+const (
+	APIVersion2 = 2 // Plain-text rich text fields (Server, Data Center and Cloud)
+	APIVersion3 = 3 // Atlassian Document Format rich text fields (Cloud only)
+)
+
+// Supported description formats, i.e. how text passed in IssueFields.Description is written
+const (
+	DescriptionFormatMarkdown = "markdown" // Converted to wiki markup (v2) or ADF (v3)
+	DescriptionFormatWiki     = "wiki"     // Jira wiki markup; sent as-is on v2, converted to ADF on v3
+	DescriptionFormatRaw      = "raw"      // Sent without conversion; on v3 an ADF JSON document or plain text
+)
+
+// CustomFieldPrefix is the ID prefix of custom fields, e.g. customfield_10010
+const CustomFieldPrefix = "customfield_"
+
+// ParseDescriptionFormat validates a description format, defaulting to markdown
+func ParseDescriptionFormat(format string) (string, error) {
+	switch f := strings.ToLower(strings.TrimSpace(format)); f {
+	case "", DescriptionFormatMarkdown, "md":
+		return DescriptionFormatMarkdown, nil
+	case DescriptionFormatWiki, DescriptionFormatRaw:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown description format: %s (expected raw, markdown, or wiki)", format)
+	}
+}
+
+// apiPath rewrites a REST v2 path for the client's API version
+func (c *Client) apiPath(path string) string {
+	if c.APIVersion == APIVersion3 && strings.HasPrefix(path, "/rest/api/2/") {
+		return "/rest/api/3/" + strings.TrimPrefix(path, "/rest/api/2/")
+	}
+	return path
+}
+
+// richText encodes text written in the client's DescriptionFormat for a rich
+// text field on the client's API version
+// Returns nil for blank text, or text that converts to an empty document on v3,
+// so the field is left out: Jira rejects an ADF document without content
+func (c *Client) richText(text string) interface{} {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+
+	var doc *adf.Node
+	switch c.DescriptionFormat {
+	case DescriptionFormatRaw:
+		if c.APIVersion != APIVersion3 {
+			return text
+		}
+		if strings.HasPrefix(strings.TrimSpace(text), "{") {
+			return json.RawMessage(text)
+		}
+		doc = adf.FromText(text)
+
+	case DescriptionFormatWiki:
+		if c.APIVersion != APIVersion3 {
+			return text
+		}
+		doc = wiki.ToADF(text)
+
+	default:
+		if c.APIVersion != APIVersion3 {
+			return wiki.FromMarkdown(text)
+		}
+		doc = adf.FromMarkdown(text)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	return doc
+}
+
+// issueFieldsPayload is IssueFields as sent to the API, with the description
+// encoded for the client's API version
+type issueFieldsPayload struct {
+	IssueFields
+	Description interface{} `json:"description,omitempty"`
+}
+
+// MarshalJSON encodes issue fields with CustomFields flattened into top-level keys
+// A custom field takes precedence over a standard field with the same ID
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	type plainFields IssueFields
+	return marshalWithFields(plainFields(f), f.CustomFields)
+}
+
+// MarshalJSON encodes the payload with custom fields flattened and the description
+// replaced by its encoded form
+func (p issueFieldsPayload) MarshalJSON() ([]byte, error) {
+	type plainFields IssueFields
+	extra := make(map[string]interface{}, len(p.CustomFields)+1)
+	for id, value := range p.CustomFields {
+		extra[id] = value
+	}
+	extra["description"] = p.Description
+	return marshalWithFields(plainFields(p.IssueFields), extra)
+}
+
+// marshalWithFields encodes v as a JSON object and sets the extra keys on it
+// A nil extra value removes the key
+func marshalWithFields(v interface{}, extra map[string]interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	for key, value := range extra {
+		if value == nil {
+			delete(object, key)
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode field %s: %w", key, err)
+		}
+		object[key] = encoded
+	}
+	return json.Marshal(object)
+}
+
+// issuePayload is the request body for creating or updating an issue
+type issuePayload struct {
+	Fields issueFieldsPayload `json:"fields"`
+}
+
+// newIssuePayload builds a create/update request body for fields
+func (c *Client) newIssuePayload(fields IssueFields) issuePayload {
+	return issuePayload{Fields: issueFieldsPayload{
+		IssueFields: fields,
+		Description: c.richText(fields.Description),
+	}}
+}
+
+// decodeRichText decodes a rich text field returned as either wiki markup (v2)
+// or an ADF document (v3) into Markdown
+func decodeRichText(data json.RawMessage) (string, error) {
+	trimmed := strings.TrimSpace(string(data))
+	switch {
+	case trimmed == "" || trimmed == "null":
+		return "", nil
+	case strings.HasPrefix(trimmed, "{"):
+		doc, err := adf.Parse(data)
+		if err != nil {
+			return "", err
+		}
+		return adf.ToMarkdown(doc), nil
+	default:
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return "", fmt.Errorf("failed to parse rich text field: %w", err)
+		}
+		return wiki.ToMarkdown(text), nil
+	}
+}
+
+// UnmarshalJSON decodes issue fields, converting a wiki or ADF description to Markdown
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	type plainFields IssueFields
+	aux := struct {
+		*plainFields
+		Description json.RawMessage `json:"description"`
+	}{plainFields: (*plainFields)(f)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	description, err := decodeRichText(aux.Description)
+	if err != nil {
+		return err
+	}
+	f.Description = description
+
+	// Collect custom fields, which the server returns as top-level customfield_NNNNN keys
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for id, value := range raw {
+		if !strings.HasPrefix(id, CustomFieldPrefix) || string(value) == "null" {
+			continue
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return fmt.Errorf("failed to parse field %s: %w", id, err)
+		}
+		if f.CustomFields == nil {
+			f.CustomFields = make(map[string]interface{})
+		}
+		f.CustomFields[id] = decoded
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateIssueV3SendsADF(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &gotBody)
+		w.Write([]byte(`{"id":"1","key":"PROJ-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.APIVersion = APIVersion3
+	service := NewIssueService(client)
+	service.Idempotent = false
+
+	_, err := service.CreateIssueWithFields(IssueFields{Summary: "Test", Description: "## Heading\n- item"})
+	if err != nil {
+		t.Fatalf("CreateIssueWithFields() error = %v", err)
+	}
+
+	if gotPath != "/rest/api/3/issue" {
+		t.Errorf("request path = %s, want /rest/api/3/issue", gotPath)
+	}
+	fields := gotBody["fields"].(map[string]interface{})
+	description, ok := fields["description"].(map[string]interface{})
+	if !ok || description["type"] != "doc" {
+		t.Errorf("description = %v, want an ADF doc", fields["description"])
+	}
+}
+
+func TestCreateIssueBlankDescriptionOmitted(t *testing.T) {
+	for _, version := range []int{APIVersion2, APIVersion3} {
+		var gotBody map[string]map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&gotBody)
+			w.Write([]byte(`{"id":"1","key":"PROJ-1"}`))
+		}))
+
+		client := NewClient(server.URL, "user@example.com", "token")
+		client.APIVersion = version
+		service := NewIssueService(client)
+		service.Idempotent = false
+
+		if _, err := service.CreateIssueWithFields(IssueFields{Summary: "Test", Description: "  \n\n  "}); err != nil {
+			t.Fatalf("v%d: CreateIssueWithFields() error = %v", version, err)
+		}
+		if description, ok := gotBody["fields"]["description"]; ok {
+			t.Errorf("v%d: description = %v, want it left out", version, description)
+		}
+		server.Close()
+	}
+}
+
+func TestIssueFieldsDecodeDescription(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		expected string
+	}{
+		{"v2 wiki markup", `{"summary":"s","description":"plain *text* in C:\\temp"}`, `plain **text** in C:\\temp`},
+		{"v3 ADF", `{"summary":"s","description":{"type":"doc","version":1,"content":[{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Title"}]}]}}`, "## Title"},
+		{"null", `{"summary":"s","description":null}`, ""},
+		{"missing", `{"summary":"s"}`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fields IssueFields
+			if err := json.Unmarshal([]byte(tt.json), &fields); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if fields.Summary != "s" {
+				t.Errorf("Summary = %q, want s", fields.Summary)
+			}
+			if fields.Description != tt.expected {
+				t.Errorf("Description = %q, want %q", fields.Description, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRichTextDescriptionFormats(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiVersion int
+		format     string
+		input      string
+		expected   string
+	}{
+		{"v2 markdown converts to wiki", APIVersion2, DescriptionFormatMarkdown, "## Title\n- item", `"h2. Title\n\n* item"`},
+		{"v2 default is markdown", APIVersion2, "", "**bold**", `"*bold*"`},
+		{"v2 wiki is sent as-is", APIVersion2, DescriptionFormatWiki, "h2. Title", `"h2. Title"`},
+		{"v2 raw is sent as-is", APIVersion2, DescriptionFormatRaw, "## not converted", `"## not converted"`},
+		{"v3 wiki converts to ADF", APIVersion3, DescriptionFormatWiki, "h1. T", `{"type":"doc","version":1,"content":[{"type":"heading","attrs":{"level":1},"content":[{"type":"text","text":"T"}]}]}`},
+		{"v3 raw text becomes plain ADF", APIVersion3, DescriptionFormatRaw, "*x*", `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"*x*"}]}]}`},
+		{"v3 raw JSON passes through", APIVersion3, DescriptionFormatRaw, `{"type":"doc"}`, `{"type":"doc"}`},
+		{"v3 empty is omitted", APIVersion3, DescriptionFormatMarkdown, "", `null`},
+		{"v3 whitespace is omitted", APIVersion3, DescriptionFormatMarkdown, " \n\t\n ", `null`},
+		{"v3 whitespace wiki is omitted", APIVersion3, DescriptionFormatWiki, "\n\n", `null`},
+		{"v2 whitespace is omitted", APIVersion2, DescriptionFormatMarkdown, "  \n", `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{APIVersion: tt.apiVersion, DescriptionFormat: tt.format}
+			data, err := json.Marshal(client.richText(tt.input))
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("richText(%q) = %s, want %s", tt.input, data, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseDescriptionFormat(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  string
+		wantError bool
+	}{
+		{"", DescriptionFormatMarkdown, false},
+		{"Markdown", DescriptionFormatMarkdown, false},
+		{"wiki", DescriptionFormatWiki, false},
+		{"raw", DescriptionFormatRaw, false},
+		{"html", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDescriptionFormat(tt.input)
+			if (err != nil) != tt.wantError {
+				t.Errorf("ParseDescriptionFormat() error = %v, wantError %v", err, tt.wantError)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseDescriptionFormat() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DefaultSearchPageSize is the number of issues requested per page when SearchOptions.PageSize is unset
+const DefaultSearchPageSize = 50
+
+// ErrStopSearch can be returned from a SearchAll callback to stop without an error
+var ErrStopSearch = errors.New("stop search")
+
+// SearchOptions controls a paginated JQL search
+type SearchOptions struct {
+	Fields     []string // Fields to return, e.g. "summary", "status" (nil = all navigable fields)
+	Expand     []string // Expansions, e.g. "names", "renderedFields"
+	MaxResults int      // Stop after this many issues (0 = no limit)
+	PageSize   int      // Issues requested per page (0 = DefaultSearchPageSize)
+}
+
+// GetOptions controls which fields and expansions are returned for a single issue
+type GetOptions struct {
+	Fields []string // Fields to return (nil = all fields)
+	Expand []string // Expansions, e.g. "changelog", "renderedFields", "names"
+}
+
+// SearchIterator walks every page of a JQL search, fetching each page only
+// when the previous one has been consumed
+//
+//	it := issueService.Search(ctx, jql, jira.SearchOptions{})
+//	for it.Next() {
+//		issue := it.Issue()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type SearchIterator struct {
+	ctx    context.Context
+	client *Client
+	jql    string
+	opts   SearchOptions
+
+	page    []Issue
+	pos     int
+	current Issue
+	count   int
+
+	startAt       int    // Offset pagination (REST v2)
+	nextPageToken string // Token pagination (REST v3 /search/jql)
+	last          bool
+	total         int
+	names         map[string]string
+	err           error
+}
+
+// Search returns an iterator over all issues matching jql
+// On REST v3 clients it uses token-based pagination via /rest/api/3/search/jql
+func (s *IssueService) Search(ctx context.Context, jql string, opts SearchOptions) *SearchIterator {
+	return s.client.Search(ctx, jql, opts)
+}
+
+// SearchAll calls fn for every issue matching jql, one page in memory at a time
+// Returning ErrStopSearch from fn stops the search without an error
+func (s *IssueService) SearchAll(ctx context.Context, jql string, opts SearchOptions, fn func(Issue) error) error {
+	it := s.Search(ctx, jql, opts)
+	for it.Next() {
+		if err := fn(it.Issue()); err != nil {
+			if errors.Is(err, ErrStopSearch) {
+				return nil
+			}
+			return err
+		}
+	}
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("failed to search issues: %w", err)
+	}
+	return nil
+}
+
+// Search returns an iterator over all issues matching jql
+func (c *Client) Search(ctx context.Context, jql string, opts SearchOptions) *SearchIterator {
+	return &SearchIterator{ctx: ctx, client: c, jql: jql, opts: opts, total: -1}
+}
+
+// Next advances to the next issue, fetching the next page if needed
+// It returns false when the results are exhausted, the cap is reached, or an error occurs
+func (it *SearchIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.opts.MaxResults > 0 && it.count >= it.opts.MaxResults {
+		return false
+	}
+
+	for it.pos >= len(it.page) {
+		if it.last {
+			return false
+		}
+		if err := it.fetch(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	it.current = it.page[it.pos]
+	it.page[it.pos] = Issue{} // Release the page entry as soon as it is consumed
+	it.pos++
+	it.count++
+	return true
+}
+
+// Issue returns the current issue
+func (it *SearchIterator) Issue() Issue {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// Total returns the total number of matches reported by the server, or -1
+// when unknown (token-based pagination does not report a total)
+func (it *SearchIterator) Total() int {
+	return it.total
+}
+
+// Names returns field ID to display name mappings when "names" was expanded
+func (it *SearchIterator) Names() map[string]string {
+	return it.names
+}
+
+// fetch requests the next page of results
+func (it *SearchIterator) fetch() error {
+	size := it.opts.PageSize
+	if size <= 0 {
+		size = DefaultSearchPageSize
+	}
+	if it.opts.MaxResults > 0 {
+		if remaining := it.opts.MaxResults - it.count; remaining < size {
+			size = remaining
+		}
+	}
+
+	var resp SearchResponse
+	if err := it.client.DoContext(it.ctx, "GET", it.pagePath(size), nil, &resp); err != nil {
+		return err
+	}
+
+	it.page = resp.Issues
+	it.pos = 0
+	if len(resp.Names) > 0 {
+		it.names = resp.Names
+	}
+
+	if it.tokenPaginated() {
+		it.nextPageToken = resp.NextPageToken
+		it.last = resp.IsLast || resp.NextPageToken == "" || len(resp.Issues) == 0
+	} else {
+		it.startAt += len(resp.Issues)
+		it.total = resp.Total
+		it.last = len(resp.Issues) == 0 || it.startAt >= resp.Total
+	}
+
+	return nil
+}
+
+// tokenPaginated reports whether the search uses the REST v3 /search/jql endpoint
+func (it *SearchIterator) tokenPaginated() bool {
+	return it.client.APIVersion == APIVersion3
+}
+
+// pagePath builds the request path for the next page
+func (it *SearchIterator) pagePath(size int) string {
+	params := fieldParams(it.opts.Fields, it.opts.Expand)
+	params.Set("jql", it.jql)
+	params.Set("maxResults", fmt.Sprint(size))
+	if len(it.opts.Fields) == 0 && it.tokenPaginated() {
+		// /search/jql only returns issue IDs unless fields are requested
+		params.Set("fields", "*navigable")
+	}
+
+	if it.tokenPaginated() {
+		if it.nextPageToken != "" {
+			params.Set("nextPageToken", it.nextPageToken)
+		}
+		return "/rest/api/3/search/jql?" + params.Encode()
+	}
+
+	params.Set("startAt", fmt.Sprint(it.startAt))
+	return "/rest/api/2/search?" + params.Encode()
+}
+
+// fieldParams builds the fields and expand query parameters shared by get and search
+func fieldParams(fields, expand []string) url.Values {
+	params := url.Values{}
+	if len(fields) > 0 {
+		params.Set("fields", strings.Join(fields, ","))
+	}
+	if len(expand) > 0 {
+		params.Set("expand", strings.Join(expand, ","))
+	}
+	return params
+}
@@ -0,0 +1,145 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newOffsetSearchServer serves total issues from /rest/api/2/search, recording each request's query
+func newOffsetSearchServer(t *testing.T, total int, queries *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/search" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		*queries = append(*queries, r.URL.RawQuery)
+
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+		var issues []string
+		for i := startAt; i < total && i < startAt+maxResults; i++ {
+			issues = append(issues, fmt.Sprintf(`{"key":"PROJ-%d"}`, i+1))
+		}
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":%d,"total":%d,"issues":[%s]}`, startAt, maxResults, total, strings.Join(issues, ","))
+	}))
+}
+
+func TestSearchIteratorOffsetPagination(t *testing.T) {
+	tests := []struct {
+		name         string
+		total        int
+		maxResults   int
+		wantIssues   int
+		wantRequests int
+	}{
+		{"Multiple pages", 120, 0, 120, 3},
+		{"Exact page boundary", 100, 0, 100, 2},
+		{"Capped", 120, 70, 70, 2},
+		{"Empty", 0, 0, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries []string
+			server := newOffsetSearchServer(t, tt.total, &queries)
+			defer server.Close()
+
+			service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+			it := service.Search(context.Background(), "project = PROJ", SearchOptions{MaxResults: tt.maxResults})
+
+			count := 0
+			for it.Next() {
+				count++
+				if want := fmt.Sprintf("PROJ-%d", count); it.Issue().Key != want {
+					t.Errorf("issue %d = %s, want %s", count, it.Issue().Key, want)
+				}
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+
+			if count != tt.wantIssues {
+				t.Errorf("got %d issues, want %d", count, tt.wantIssues)
+			}
+			if len(queries) != tt.wantRequests {
+				t.Errorf("made %d requests, want %d", len(queries), tt.wantRequests)
+			}
+			if it.Total() != tt.total {
+				t.Errorf("Total() = %d, want %d", it.Total(), tt.total)
+			}
+		})
+	}
+}
+
+func TestSearchIteratorTokenPagination(t *testing.T) {
+	pages := map[string]string{
+		"":      `{"issues":[{"key":"PROJ-1"},{"key":"PROJ-2"}],"nextPageToken":"page2","names":{"summary":"Summary"}}`,
+		"page2": `{"issues":[{"key":"PROJ-3"}],"isLast":true}`,
+	}
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		query := r.URL.Query()
+		if query.Get("fields") != "summary,status" || query.Get("expand") != "names" {
+			t.Errorf("fields = %q, expand = %q", query.Get("fields"), query.Get("expand"))
+		}
+		fmt.Fprint(w, pages[query.Get("nextPageToken")])
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	client.APIVersion = APIVersion3
+	service := NewIssueService(client)
+
+	var keys []string
+	err := service.SearchAll(context.Background(), "project = PROJ", SearchOptions{
+		Fields: []string{"summary", "status"},
+		Expand: []string{"names"},
+	}, func(issue Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("SearchAll() error = %v", err)
+	}
+
+	if strings.Join(keys, ",") != "PROJ-1,PROJ-2,PROJ-3" {
+		t.Errorf("keys = %v, want PROJ-1..PROJ-3", keys)
+	}
+	for _, path := range paths {
+		if path != "/rest/api/3/search/jql" {
+			t.Errorf("request path = %s, want /rest/api/3/search/jql", path)
+		}
+	}
+	if len(paths) != 2 {
+		t.Errorf("made %d requests, want 2", len(paths))
+	}
+}
+
+func TestSearchAllStop(t *testing.T) {
+	var queries []string
+	server := newOffsetSearchServer(t, 200, &queries)
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+
+	count := 0
+	err := service.SearchAll(context.Background(), "project = PROJ", SearchOptions{}, func(issue Issue) error {
+		count++
+		if count == 3 {
+			return ErrStopSearch
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("SearchAll() error = %v", err)
+	}
+	if count != 3 || len(queries) != 1 {
+		t.Errorf("visited %d issues in %d requests, want 3 in 1", count, len(queries))
+	}
+}
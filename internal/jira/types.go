@@ -8,6 +8,37 @@ type Issue struct {
 	Fields IssueFields `json:"fields"`
 	Self   string      `json:"self,omitempty"`
 	ID     string      `json:"id,omitempty"`
+
+	// Present only when requested via expand
+	Changelog      *Changelog             `json:"changelog,omitempty"`
+	RenderedFields map[string]interface{} `json:"renderedFields,omitempty"`
+	Names          map[string]string      `json:"names,omitempty"`
+}
+
+// Changelog is an issue's change history, returned with expand=changelog
+type Changelog struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	Histories  []ChangelogHistory `json:"histories"`
+}
+
+// ChangelogHistory is a single set of changes made to an issue
+type ChangelogHistory struct {
+	ID      string          `json:"id"`
+	Author  *User           `json:"author,omitempty"`
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// ChangelogItem is a single field change within a ChangelogHistory
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
 }
 
 // IssueFields contains the fields for creating/updating a JIRA issue
@@ -21,7 +52,16 @@ type IssueFields struct {
 	Status       *Status                `json:"status,omitempty"`
 	Labels       []string               `json:"labels,omitempty"`
 	Components   []Component            `json:"components,omitempty"`
-	CustomFields map[string]interface{} `json:"customfields,omitempty"`
+	Parent       *Parent                `json:"parent,omitempty"`
+	FixVersions  []Version              `json:"fixVersions,omitempty"`
+	Versions     []Version              `json:"versions,omitempty"` // Affects versions
+	CustomFields map[string]interface{} `json:"-"`                  // Keyed by field ID; sent and received as top-level fields
+
+	// Comment, Attachment, IssueLinks and Subtasks are returned when fetching issues; they are never sent on create or update
+	Comment    *CommentPage `json:"comment,omitempty"`
+	Attachment []Attachment `json:"attachment,omitempty"`
+	IssueLinks []IssueLink  `json:"issuelinks,omitempty"`
+	Subtasks   []Issue      `json:"subtasks,omitempty"`
 }
 
 // Project represents a JIRA project reference
@@ -31,8 +71,18 @@ type Project struct {
 
 // IssueType represents a JIRA issue type
 type IssueType struct {
-	Name string `json:"name"`
-	ID   string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	ID      string `json:"id,omitempty"`
+	Subtask bool   `json:"subtask,omitempty"`
+}
+
+// Parent is a reference to an issue's parent: the epic of a standard issue,
+// or the standard issue of a sub-task
+// Only Key (or ID) is sent; Fields holds the summary, status and type when fetched
+type Parent struct {
+	ID     string       `json:"id,omitempty"`
+	Key    string       `json:"key,omitempty"`
+	Fields *IssueFields `json:"fields,omitempty"`
 }
 
 // Priority represents a JIRA priority level
@@ -52,6 +102,7 @@ type User struct {
 	Name         string `json:"name,omitempty"`
 	EmailAddress string `json:"emailAddress,omitempty"`
 	AccountID    string `json:"accountId,omitempty"`
+	DisplayName  string `json:"displayName,omitempty"`
 }
 
 // Component represents a JIRA component
@@ -60,8 +111,26 @@ type Component struct {
 	ID   string `json:"id,omitempty"`
 }
 
+// Version is a project version (release)
+// Only Name (or ID) is needed when setting an issue's fix or affects versions
+type Version struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Project     string `json:"project,omitempty"` // Project key; set when creating a version
+	ProjectID   int    `json:"projectId,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`   // YYYY-MM-DD
+	ReleaseDate string `json:"releaseDate,omitempty"` // YYYY-MM-DD
+	Released    bool   `json:"released,omitempty"`
+	Archived    bool   `json:"archived,omitempty"`
+	Overdue     bool   `json:"overdue,omitempty"`
+}
+
 // IssueLink represents a link between two issues
+// As returned in an issue's "issuelinks" field, only the linked issue is set:
+// OutwardIssue for links described by Type.Outward, InwardIssue for Type.Inward
 type IssueLink struct {
+	ID           string   `json:"id,omitempty"`
 	Type         LinkType `json:"type"`
 	InwardIssue  *Issue   `json:"inwardIssue,omitempty"`
 	OutwardIssue *Issue   `json:"outwardIssue,omitempty"`
@@ -69,8 +138,10 @@ type IssueLink struct {
 
 // LinkType represents the type of link (e.g., "Blocks", "Relates")
 type LinkType struct {
-	Name string `json:"name"`
-	ID   string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	ID      string `json:"id,omitempty"`
+	Inward  string `json:"inward,omitempty"`  // e.g. "is blocked by"
+	Outward string `json:"outward,omitempty"` // e.g. "blocks"
 }
 
 // CreateIssueRequest is the request body for creating an issue
@@ -105,6 +176,7 @@ type TicketRecord struct {
 	Priority         string     `json:"priority"`
 	IssueType        string     `json:"issue_type"`
 	Project          string     `json:"project,omitempty"` // Logical project name for grouping
+	Parent           string     `json:"parent,omitempty"`  // Key of the parent epic or issue
 }
 
 // SearchResponse is the response from a search query
@@ -114,6 +186,13 @@ type SearchResponse struct {
 	MaxResults int     `json:"maxResults"`
 	Total      int     `json:"total"`
 	Issues     []Issue `json:"issues"`
+
+	// Token-based pagination (REST v3 /search/jql)
+	NextPageToken string `json:"nextPageToken,omitempty"`
+	IsLast        bool   `json:"isLast,omitempty"`
+
+	// Field ID to display name, present when "names" is expanded
+	Names map[string]string `json:"names,omitempty"`
 }
 
 // Transition represents a workflow transition
@@ -134,6 +213,7 @@ type Transition struct {
 			Name      string `json:"name"`
 		} `json:"statusCategory"`
 	} `json:"to"`
+	Fields map[string]FieldMeta `json:"fields,omitempty"` // Fields on the transition screen, keyed by field ID
 }
 
 // TransitionsResponse is the response from getting transitions
@@ -146,7 +226,8 @@ type TransitionRequest struct {
 	Transition struct {
 		ID string `json:"id"`
 	} `json:"transition"`
-	Fields IssueFields `json:"fields,omitempty"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+	Update map[string]interface{} `json:"update,omitempty"`
 }
 
 // UpdateIssueRequest is the request body for updating an issue
@@ -168,7 +249,36 @@ type CreateMetadataProject struct {
 
 // CreateMetadataIssueType contains information about an issue type
 type CreateMetadataIssueType struct {
-	Name   string                 `json:"name"`
-	ID     string                 `json:"id"`
-	Fields map[string]interface{} `json:"fields"`
+	Name    string               `json:"name"`
+	ID      string               `json:"id"`
+	Subtask bool                 `json:"subtask,omitempty"`
+	Fields  map[string]FieldMeta `json:"fields,omitempty"` // Keyed by field ID
+}
+
+// FieldMeta describes a field on an issue type's create screen
+type FieldMeta struct {
+	FieldID         string         `json:"fieldId,omitempty"` // Set by the paginated createmeta endpoints
+	Key             string         `json:"key,omitempty"`
+	Name            string         `json:"name"`
+	Required        bool           `json:"required"`
+	HasDefaultValue bool           `json:"hasDefaultValue,omitempty"`
+	Schema          *FieldSchema   `json:"schema,omitempty"`
+	AllowedValues   []AllowedValue `json:"allowedValues,omitempty"`
+}
+
+// AllowedValue is one of the values a field accepts: an option (Value) or a
+// named object such as a priority, component or version (Name)
+type AllowedValue struct {
+	ID       string         `json:"id,omitempty"`
+	Name     string         `json:"name,omitempty"`
+	Value    string         `json:"value,omitempty"`
+	Children []AllowedValue `json:"children,omitempty"` // Options of a cascading select
+}
+
+// Label returns the allowed value's option value or name
+func (a AllowedValue) Label() string {
+	if a.Value != "" {
+		return a.Value
+	}
+	return a.Name
 }
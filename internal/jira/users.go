@@ -0,0 +1,267 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultUserCacheTTL is how long a resolved user is reused before it is looked up again
+const DefaultUserCacheTTL = 24 * time.Hour
+
+// userCacheEntry is a resolved user as stored in the cache file
+type userCacheEntry struct {
+	User     User      `json:"user"`
+	Resolved time.Time `json:"resolved"`
+}
+
+// UserService resolves emails, display names and usernames to users that can be
+// set as an assignee: an accountId on Jira Cloud, a username on Server and Data Center
+// Resolved users are cached in memory and, when a cache path is set, on disk
+type UserService struct {
+	client    *Client
+	cachePath string // Empty for an in-memory cache only
+	ttl       time.Duration
+
+	mu     sync.Mutex
+	cache  map[string]userCacheEntry
+	loaded bool
+	now    func() time.Time
+}
+
+// DefaultUserCachePath returns the path of the on-disk user cache, ~/.jira/users.json
+func DefaultUserCachePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".jira", "users.json"), nil
+}
+
+// NewUserService creates a user service cached at DefaultUserCachePath for DefaultUserCacheTTL
+// When the home directory is unknown, users are cached in memory only
+func NewUserService(client *Client) *UserService {
+	path, err := DefaultUserCachePath()
+	if err != nil {
+		path = ""
+	}
+	return NewUserServiceWithCache(client, path, DefaultUserCacheTTL)
+}
+
+// NewUserServiceWithCache creates a user service with the given cache file and TTL
+// An empty path keeps the cache in memory only
+func NewUserServiceWithCache(client *Client, path string, ttl time.Duration) *UserService {
+	return &UserService{
+		client:    client,
+		cachePath: path,
+		ttl:       ttl,
+		cache:     make(map[string]userCacheEntry),
+		now:       time.Now,
+	}
+}
+
+// ResolveAssignee resolves query to a reference that can be set as IssueFields.Assignee
+// When projectKey is set, only users assignable in that project are considered
+func (s *UserService) ResolveAssignee(ctx context.Context, query, projectKey string) (*User, error) {
+	user, err := s.ResolveUser(ctx, query, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	return user.Ref(), nil
+}
+
+// ResolveUser finds the single user matching query, an email address, display name,
+// username or accountId. When projectKey is set, the user must be assignable there
+// Several matching users is an error listing them, since the intended one can't be known
+func (s *UserService) ResolveUser(ctx context.Context, query, projectKey string) (*User, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("user is required")
+	}
+
+	cacheKey := s.client.BaseURL + "|" + projectKey + "|" + strings.ToLower(query)
+	if user, ok := s.cached(cacheKey); ok {
+		return user, nil
+	}
+
+	var users []User
+	var err error
+	if projectKey != "" {
+		params := url.Values{"project": {projectKey}}
+		users, err = s.search(ctx, "/rest/api/2/user/assignable/search", params, query)
+	} else {
+		users, err = s.search(ctx, "/rest/api/2/user/search", url.Values{}, query)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user %s: %w", query, err)
+	}
+
+	user, err := matchUser(users, query)
+	if err != nil {
+		if len(users) == 0 && projectKey != "" {
+			// Tell a user who can't be assigned apart from one who doesn't exist
+			if all, searchErr := s.search(ctx, "/rest/api/2/user/search", url.Values{}, query); searchErr == nil {
+				if _, matchErr := matchUser(all, query); matchErr == nil {
+					return nil, fmt.Errorf("user %s cannot be assigned issues in project %s", query, projectKey)
+				}
+			}
+		}
+		return nil, err
+	}
+
+	s.store(cacheKey, *user)
+	return user, nil
+}
+
+// search queries a user search endpoint, using the Cloud "query" parameter and
+// falling back to the Server and Data Center "username" parameter
+func (s *UserService) search(ctx context.Context, path string, params url.Values, query string) ([]User, error) {
+	var users []User
+	params.Set("query", query)
+	err := s.client.DoContext(ctx, "GET", path+"?"+params.Encode(), nil, &users)
+
+	var jiraErr *JiraError
+	if errors.As(err, &jiraErr) && (jiraErr.StatusCode == 400 || jiraErr.StatusCode == 404) {
+		params.Del("query")
+		params.Set("username", query)
+		users = nil
+		err = s.client.DoContext(ctx, "GET", path+"?"+params.Encode(), nil, &users)
+	}
+	return users, err
+}
+
+// matchUser picks the user that query names from search results
+// Only an exact match on accountId, email, username or display name is accepted,
+// since user search also returns prefix matches; those are named in the error.
+// Jira Cloud usually hides email addresses, so the only result of an email
+// query is taken to be that user
+func matchUser(users []User, query string) (*User, error) {
+	var exact []User
+	for _, u := range users {
+		if u.AccountID == query || strings.EqualFold(u.EmailAddress, query) ||
+			strings.EqualFold(u.Name, query) || strings.EqualFold(u.DisplayName, query) {
+			exact = append(exact, u)
+		}
+	}
+
+	switch {
+	case len(exact) == 1:
+		return &exact[0], nil
+	case len(exact) > 1:
+		return nil, fmt.Errorf("%d users match %s; use an email address or accountId: %s", len(exact), query, describeUsers(exact))
+	case len(users) == 1 && strings.Contains(query, "@"):
+		return &users[0], nil
+	case len(users) > 0:
+		return nil, fmt.Errorf("no user matches %s exactly; did you mean %s", query, describeUsers(users))
+	default:
+		return nil, fmt.Errorf("no user matches %s", query)
+	}
+}
+
+// describeUsers lists users for an error message
+func describeUsers(users []User) string {
+	names := make([]string, len(users))
+	for i, u := range users {
+		names[i] = u.Describe()
+	}
+	return strings.Join(names, "; ")
+}
+
+// Ref returns the reference used to set the user on a field: the accountId on
+// Jira Cloud, the username on Server and Data Center
+func (u *User) Ref() *User {
+	if u.AccountID != "" {
+		return &User{AccountID: u.AccountID}
+	}
+	return &User{Name: u.Name}
+}
+
+// Describe formats the user as "Display Name <email> (id)", omitting unknown parts
+func (u *User) Describe() string {
+	parts := []string{u.DisplayName}
+	if u.EmailAddress != "" {
+		parts = append(parts, "<"+u.EmailAddress+">")
+	}
+	id := u.AccountID
+	if id == "" {
+		id = u.Name
+	}
+	if id != "" {
+		parts = append(parts, "("+id+")")
+	}
+	return strings.TrimSpace(strings.Join(parts, " "))
+}
+
+// cached returns an unexpired cached user
+func (s *UserService) cached(key string) (*User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.load()
+	entry, ok := s.cache[key]
+	if !ok || s.now().Sub(entry.Resolved) > s.ttl {
+		return nil, false
+	}
+	user := entry.User
+	return &user, true
+}
+
+// store caches a resolved user and writes the cache file
+// The cache is best effort, so a failed write is ignored
+func (s *UserService) store(key string, user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.load()
+	s.cache[key] = userCacheEntry{User: user, Resolved: s.now()}
+	if s.cachePath == "" {
+		return
+	}
+
+	// Drop expired entries so the file doesn't grow forever
+	for k, entry := range s.cache {
+		if s.now().Sub(entry.Resolved) > s.ttl {
+			delete(s.cache, k)
+		}
+	}
+
+	data, err := json.MarshalIndent(s.cache, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(s.cachePath), 0700); err != nil {
+		return
+	}
+	os.WriteFile(s.cachePath, data, 0600)
+}
+
+// load reads the cache file once; a missing or corrupt file starts an empty cache
+// The caller must hold s.mu
+func (s *UserService) load() {
+	if s.loaded {
+		return
+	}
+	s.loaded = true
+	if s.cachePath == "" {
+		return
+	}
+
+	data, err := os.ReadFile(s.cachePath)
+	if err != nil {
+		return
+	}
+	var entries map[string]userCacheEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return
+	}
+	for k, entry := range entries {
+		s.cache[k] = entry
+	}
+}
@@ -0,0 +1,154 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testJaneUsersJSON = `[
+	{"accountId":"acc-1","displayName":"Jane Smith","emailAddress":"jane@example.com"},
+	{"accountId":"acc-2","displayName":"Jane Smith","emailAddress":"jane.smith@example.com"},
+	{"accountId":"acc-9","displayName":"Janet"}
+]`
+
+func TestResolveAssignee(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/2/user/assignable/search" && r.URL.Query().Get("project") != "PROJ" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("query") {
+		case "jane@example.com", "Jane Smith", "jane":
+			w.Write([]byte(testJaneUsersJSON))
+		case "jdoe@example.com":
+			// Cloud hides the email address of most users
+			w.Write([]byte(`[{"accountId":"acc-3","displayName":"John Doe"}]`))
+		case "john", "john doe":
+			w.Write([]byte(`[{"accountId":"acc-3","displayName":"John Doe"}]`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		query     string
+		wantID    string
+		wantError string
+	}{
+		{"exact email among several", "jane@example.com", "acc-1", ""},
+		{"exact display name", "john doe", "acc-3", ""},
+		{"single result with hidden email", "jdoe@example.com", "acc-3", ""},
+		{"single partial match", "john", "", "did you mean John Doe (acc-3)"},
+		{"ambiguous display name", "Jane Smith", "", "2 users match Jane Smith"},
+		{"ambiguous partial", "jane", "", "Janet (acc-9)"},
+		{"no match", "nobody", "", "no user matches nobody"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewUserServiceWithCache(NewClient(server.URL, "user@example.com", "token"), "", time.Hour)
+			user, err := service.ResolveAssignee(context.Background(), tt.query, "PROJ")
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveAssignee() error = %v", err)
+			}
+			if user.AccountID != tt.wantID || user.EmailAddress != "" || user.Name != "" {
+				t.Errorf("assignee = %+v, want only accountId %s", user, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestResolveAssigneeFallsBackToUsername(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("query") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errorMessages":["The username query parameter was not provided"]}`))
+			return
+		}
+		if r.URL.Query().Get("username") != "jsmith" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`[{"name":"jsmith","key":"JIRAUSER10100","displayName":"Jane Smith"}]`))
+	}))
+	defer server.Close()
+
+	service := NewUserServiceWithCache(NewClient(server.URL, "user@example.com", "token"), "", time.Hour)
+	user, err := service.ResolveAssignee(context.Background(), "jsmith", "PROJ")
+	if err != nil {
+		t.Fatalf("ResolveAssignee() error = %v", err)
+	}
+	if user.Name != "jsmith" || user.AccountID != "" {
+		t.Errorf("assignee = %+v, want name jsmith", user)
+	}
+}
+
+func TestResolveAssigneeNotAssignable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/2/user/assignable/search" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"accountId":"acc-5","displayName":"Contractor","emailAddress":"c@example.com"}]`))
+	}))
+	defer server.Close()
+
+	service := NewUserServiceWithCache(NewClient(server.URL, "user@example.com", "token"), "", time.Hour)
+	_, err := service.ResolveAssignee(context.Background(), "c@example.com", "PROJ")
+	if err == nil || !strings.Contains(err.Error(), "cannot be assigned issues in project PROJ") {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func TestUserCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`[{"accountId":"acc-3","displayName":"John Doe"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	cachePath := filepath.Join(t.TempDir(), "users.json")
+	ctx := context.Background()
+
+	first := NewUserServiceWithCache(client, cachePath, time.Hour)
+	for i := 0; i < 2; i++ {
+		if _, err := first.ResolveAssignee(ctx, "John Doe", "PROJ"); err != nil {
+			t.Fatalf("ResolveAssignee() error = %v", err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d after repeated lookups, want 1", requests)
+	}
+
+	// A new service reads the cache file
+	second := NewUserServiceWithCache(client, cachePath, time.Hour)
+	user, err := second.ResolveAssignee(ctx, "john doe", "PROJ")
+	if err != nil || user.AccountID != "acc-3" {
+		t.Fatalf("ResolveAssignee() = %+v, %v", user, err)
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d with a cache file, want 1", requests)
+	}
+
+	// Expired entries are looked up again
+	second.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := second.ResolveAssignee(ctx, "John Doe", "PROJ"); err != nil {
+		t.Fatalf("ResolveAssignee() error = %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d after expiry, want 2", requests)
+	}
+}
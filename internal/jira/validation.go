@@ -1,13 +1,33 @@
 package jira
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 )
 
+// Default priorities and issue types, used to validate without a server
+var (
+	defaultPriorities = []string{"Lowest", "Low", "Medium", "High", "Highest"}
+	defaultIssueTypes = []string{"Task", "Story", "Bug", "Epic", "Subtask"}
+)
+
+// maxListedValues is the most allowed values listed in a validation error
+const maxListedValues = 15
+
 // Validator handles validation of JIRA data
+// With a client, issues are checked against each project's create metadata and the
+// server's priorities, which are fetched once and cached for the lifetime of the validator
 type Validator struct {
 	client *Client
+
+	mu         sync.Mutex
+	issueTypes map[string][]CreateMetadataIssueType // Keyed by project key; fields are loaded on first use
+	priorities []Priority
 }
 
 // NewValidator creates a new validator
@@ -15,39 +35,132 @@ func NewValidator(client *Client) *Validator {
 	return &Validator{client: client}
 }
 
-// ValidatePriority checks if a priority value is valid
+// ValidatePriority checks a priority against JIRA's default priorities
+// Use ValidatePriorityContext to check against the server's priority scheme
 func (v *Validator) ValidatePriority(priority string) error {
-	validPriorities := []string{"Lowest", "Low", "Medium", "High", "Highest"}
-	for _, valid := range validPriorities {
-		if strings.EqualFold(priority, valid) {
-			return nil
-		}
+	return matchAllowed("priority", "invalid priority", priority, defaultPriorities)
+}
+
+// ValidatePriorityContext checks a priority against the priorities defined on the server
+func (v *Validator) ValidatePriorityContext(ctx context.Context, priority string) error {
+	if v.client == nil {
+		return v.ValidatePriority(priority)
 	}
-	return &ValidationError{
-		Field:   "priority",
-		Message: "invalid priority",
-		Details: fmt.Sprintf("must be one of: %s", strings.Join(validPriorities, ", ")),
+
+	priorities, err := v.getPriorities(ctx)
+	if err != nil {
+		return err
+	}
+	names := make([]string, len(priorities))
+	for i, p := range priorities {
+		names[i] = p.Name
 	}
+	return matchAllowed("priority", "invalid priority", priority, names)
 }
 
-// ValidateIssueType checks if an issue type is valid
+// ValidateIssueType checks an issue type against JIRA's default issue types
+// Use ValidateIssueTypeContext to check against the types a project can create
 func (v *Validator) ValidateIssueType(issueType string) error {
-	validTypes := []string{"Task", "Story", "Bug", "Epic", "Subtask"}
-	for _, valid := range validTypes {
-		if strings.EqualFold(issueType, valid) {
-			return nil
+	return matchAllowed("issue_type", "invalid issue type", issueType, defaultIssueTypes)
+}
+
+// ValidateIssueTypeContext checks that an issue type can be created in a project
+func (v *Validator) ValidateIssueTypeContext(ctx context.Context, projectKey, issueType string) error {
+	if v.client == nil {
+		return v.ValidateIssueType(issueType)
+	}
+	_, err := v.findIssueType(ctx, projectKey, issueType)
+	return err
+}
+
+// ValidateIssue checks fields against the project's create metadata: the summary,
+// issue type and priority, that every required field is set, that every set field
+// is on the create screen, and that values such as components, versions and select
+// options are allowed. Every problem is reported in a single ValidationErrors
+func (v *Validator) ValidateIssue(ctx context.Context, fields IssueFields) error {
+	var problems ValidationErrors
+	problems.Add(v.ValidateSummary(fields.Summary))
+
+	// Without a server, only the defaults can be checked
+	if v.client == nil {
+		problems.Add(v.ValidateIssueType(fields.IssueType.Name))
+		if fields.Priority != nil && fields.Priority.Name != "" {
+			problems.Add(v.ValidatePriority(fields.Priority.Name))
 		}
+		return problems.Err()
 	}
-	return &ValidationError{
-		Field:   "issue_type",
-		Message: "invalid issue type",
-		Details: fmt.Sprintf("must be one of: %s", strings.Join(validTypes, ", ")),
+
+	projectKey := fields.Project.Key
+	if projectKey == "" {
+		problems.Add(&ValidationError{Field: "project", Message: "project key is required"})
+		return problems.Err()
+	}
+
+	issueType, err := v.findIssueType(ctx, projectKey, fields.IssueType.Name)
+	if err != nil {
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			return err
+		}
+		problems.Add(err)
+		if fields.Priority != nil && fields.Priority.Name != "" {
+			problems.Add(v.ValidatePriorityContext(ctx, fields.Priority.Name))
+		}
+		return problems.Err()
+	}
+
+	metas, err := v.createFields(ctx, projectKey, issueType)
+	if err != nil {
+		return err
 	}
+
+	values, err := setFieldValues(fields)
+	if err != nil {
+		return err
+	}
+
+	// Required fields without a default must be set
+	for _, id := range sortedFieldIDs(metas) {
+		meta := metas[id]
+		if _, ok := values[id]; !ok && meta.Required && !meta.HasDefaultValue {
+			problems.Add(&ValidationError{
+				Field:   meta.Name,
+				Message: fmt.Sprintf("field is required for %s tickets in %s", issueType.Name, projectKey),
+			})
+		}
+	}
+
+	// Set fields must be on the create screen and hold allowed values
+	for _, id := range sortedFieldIDs(values) {
+		if id == "project" || id == "issuetype" {
+			continue
+		}
+		meta, ok := metas[id]
+		if !ok {
+			problems.Add(&ValidationError{
+				Field:   id,
+				Message: fmt.Sprintf("field is not on the create screen for %s tickets in %s", issueType.Name, projectKey),
+			})
+			continue
+		}
+		if id == "priority" && len(meta.AllowedValues) == 0 {
+			problems.Add(v.ValidatePriorityContext(ctx, fields.Priority.Name))
+			continue
+		}
+		problems.Add(checkAllowedValues(meta, values[id]))
+	}
+
+	return problems.Err()
 }
 
 // ValidateTicketExists checks if a ticket with the given key exists
 func (v *Validator) ValidateTicketExists(key string) error {
-	_, err := v.client.GetIssue(key)
+	return v.ValidateTicketExistsContext(context.Background(), key)
+}
+
+// ValidateTicketExistsContext checks if a ticket exists, honoring ctx cancellation
+func (v *Validator) ValidateTicketExistsContext(ctx context.Context, key string) error {
+	_, err := v.client.GetIssueContext(ctx, key)
 	if _, ok := err.(*NotFoundError); ok {
 		return &ValidationError{
 			Field:   "ticket_key",
@@ -60,8 +173,13 @@ func (v *Validator) ValidateTicketExists(key string) error {
 
 // ValidateTicketsExist checks if multiple tickets with the given keys exist
 func (v *Validator) ValidateTicketsExist(keys []string) error {
+	return v.ValidateTicketsExistContext(context.Background(), keys)
+}
+
+// ValidateTicketsExistContext checks if multiple tickets exist, honoring ctx cancellation
+func (v *Validator) ValidateTicketsExistContext(ctx context.Context, keys []string) error {
 	for _, key := range keys {
-		if err := v.ValidateTicketExists(key); err != nil {
+		if err := v.ValidateTicketExistsContext(ctx, key); err != nil {
 			return err
 		}
 	}
@@ -142,3 +260,226 @@ func (v *Validator) ValidateLabels(labels []string) error {
 	}
 	return nil
 }
+
+// getPriorities fetches the server's priorities once
+func (v *Validator) getPriorities(ctx context.Context) ([]Priority, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.priorities != nil {
+		return v.priorities, nil
+	}
+
+	var priorities []Priority
+	if err := v.client.DoContext(ctx, "GET", "/rest/api/2/priority", nil, &priorities); err != nil {
+		return nil, fmt.Errorf("failed to get priorities: %w", err)
+	}
+	v.priorities = priorities
+	return priorities, nil
+}
+
+// getIssueTypes fetches the issue types a project can create once, falling back to
+// the expanded createmeta endpoint on servers without the paginated one
+// The caller must hold v.mu
+func (v *Validator) getIssueTypes(ctx context.Context, projectKey string) ([]CreateMetadataIssueType, error) {
+	if issueTypes, ok := v.issueTypes[projectKey]; ok {
+		return issueTypes, nil
+	}
+
+	issueTypes, err := v.client.GetCreateMetaIssueTypes(ctx, projectKey)
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		var meta *CreateMetadata
+		if meta, err = v.client.GetCreateMetadataContext(ctx, projectKey); err == nil {
+			issueTypes = nil
+			for _, project := range meta.Projects {
+				for _, issueType := range project.IssueTypes {
+					if issueType.Fields == nil {
+						issueType.Fields = make(map[string]FieldMeta)
+					}
+					issueTypes = append(issueTypes, issueType)
+				}
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get create metadata for project %s: %w", projectKey, err)
+	}
+	if len(issueTypes) == 0 {
+		return nil, fmt.Errorf("no issue types can be created in project %s", projectKey)
+	}
+
+	if v.issueTypes == nil {
+		v.issueTypes = make(map[string][]CreateMetadataIssueType)
+	}
+	v.issueTypes[projectKey] = issueTypes
+	return issueTypes, nil
+}
+
+// findIssueType finds a creatable issue type by name, ignoring case; any spelling
+// of "sub-task" matches the project's sub-task type
+func (v *Validator) findIssueType(ctx context.Context, projectKey, name string) (*CreateMetadataIssueType, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	issueTypes, err := v.getIssueTypes(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range issueTypes {
+		if strings.EqualFold(issueTypes[i].Name, strings.TrimSpace(name)) {
+			return &issueTypes[i], nil
+		}
+	}
+	if IsSubtaskType(name) {
+		for i := range issueTypes {
+			if issueTypes[i].Subtask {
+				return &issueTypes[i], nil
+			}
+		}
+	}
+
+	names := make([]string, len(issueTypes))
+	for i, issueType := range issueTypes {
+		names[i] = issueType.Name
+	}
+	return nil, matchAllowed("issue_type", fmt.Sprintf("invalid issue type for project %s", projectKey), name, names)
+}
+
+// createFields returns the fields on an issue type's create screen, fetching them once
+func (v *Validator) createFields(ctx context.Context, projectKey string, issueType *CreateMetadataIssueType) (map[string]FieldMeta, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if issueType.Fields != nil {
+		return issueType.Fields, nil
+	}
+
+	fields, err := v.client.GetCreateMetaFields(ctx, projectKey, issueType.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get create fields for %s in project %s: %w", issueType.Name, projectKey, err)
+	}
+	issueType.Fields = fields
+	return fields, nil
+}
+
+// setFieldValues returns the fields that would be sent on create, keyed by field ID,
+// leaving out unset ones
+func setFieldValues(fields IssueFields) (map[string]interface{}, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode fields: %w", err)
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to encode fields: %w", err)
+	}
+	for id, value := range values {
+		if value == nil || value == "" {
+			delete(values, id)
+		}
+	}
+	return values, nil
+}
+
+// checkAllowedValues checks each option, name or ID in value against the field's allowed values
+func checkAllowedValues(meta FieldMeta, value interface{}) error {
+	if len(meta.AllowedValues) == 0 {
+		return nil
+	}
+
+	var problems ValidationErrors
+	items, ok := value.([]interface{})
+	if !ok {
+		items = []interface{}{value}
+	}
+	for _, item := range items {
+		allowed, label, found := findAllowedValue(meta.AllowedValues, item)
+		if !found {
+			problems.Add(invalidValueError(meta.Name, label, meta.AllowedValues))
+			continue
+		}
+
+		// Cascading selects also name a child option
+		if object, ok := item.(map[string]interface{}); ok && object["child"] != nil {
+			if _, childLabel, found := findAllowedValue(allowed.Children, object["child"]); !found {
+				problems.Add(invalidValueError(meta.Name, label+" > "+childLabel, allowed.Children))
+			}
+		}
+	}
+	return problems.Err()
+}
+
+// findAllowedValue finds the allowed value matching a field value given as a
+// string or as an object with an id, value or name
+func findAllowedValue(allowed []AllowedValue, item interface{}) (AllowedValue, string, bool) {
+	var id, label string
+	switch x := item.(type) {
+	case string:
+		label = x
+	case map[string]interface{}:
+		id, _ = x["id"].(string)
+		for _, key := range []string{"value", "name"} {
+			if s, ok := x[key].(string); ok && s != "" {
+				label = s
+				break
+			}
+		}
+	default:
+		// Numbers, booleans and other shapes aren't checked
+		return AllowedValue{}, "", true
+	}
+	if label == "" {
+		label = id
+	}
+
+	for _, a := range allowed {
+		if (id != "" && a.ID == id) || (label != "" && strings.EqualFold(a.Label(), label)) {
+			return a, label, true
+		}
+	}
+	return AllowedValue{}, label, false
+}
+
+// invalidValueError reports a value not among a field's allowed values
+func invalidValueError(field, value string, allowed []AllowedValue) error {
+	labels := make([]string, len(allowed))
+	for i, a := range allowed {
+		labels[i] = a.Label()
+	}
+	return matchAllowed(field, "invalid value", value, labels)
+}
+
+// matchAllowed checks value against a list of names, ignoring case
+func matchAllowed(field, message, value string, allowed []string) error {
+	for _, valid := range allowed {
+		if strings.EqualFold(strings.TrimSpace(value), valid) {
+			return nil
+		}
+	}
+
+	listed := allowed
+	if len(listed) > maxListedValues {
+		listed = append(listed[:maxListedValues:maxListedValues], "...")
+	}
+	if value != "" {
+		message = fmt.Sprintf("%s %q", message, value)
+	}
+	return &ValidationError{
+		Field:   field,
+		Message: message,
+		Details: fmt.Sprintf("must be one of: %s", strings.Join(listed, ", ")),
+	}
+}
+
+// sortedFieldIDs returns a map's keys in order
+func sortedFieldIDs[T any](fields map[string]T) []string {
+	ids := make([]string, 0, len(fields))
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -1,6 +1,13 @@
 package jira
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
 
 func TestValidatePriority(t *testing.T) {
 	validator := &Validator{client: nil}
@@ -205,3 +212,173 @@ func make256String() string {
 	}
 	return s
 }
+
+const testCreateMetaIssueTypesJSON = `{"startAt":0,"maxResults":50,"total":2,"issueTypes":[
+	{"id":"10001","name":"Spike","subtask":false},
+	{"id":"10002","name":"Sub-task","subtask":true}
+]}`
+
+// testCreateMetaFieldsJSON is the Spike create screen, served as Data Center's "values"
+const testCreateMetaFieldsJSON = `{"startAt":0,"maxResults":50,"total":7,"isLast":true,"values":[
+	{"fieldId":"summary","name":"Summary","required":true},
+	{"fieldId":"project","name":"Project","required":true},
+	{"fieldId":"issuetype","name":"Issue Type","required":true},
+	{"fieldId":"priority","name":"Priority","required":false},
+	{"fieldId":"components","name":"Components","required":false,"allowedValues":[{"id":"1","name":"Backend"},{"id":"2","name":"Frontend"}]},
+	{"fieldId":"fixVersions","name":"Fix Version/s","required":false,"allowedValues":[{"id":"100","name":"2.4.0"}]},
+	{"fieldId":"customfield_10070","name":"Hardware","required":true,"allowedValues":[{"id":"20","value":"Laptop","children":[{"id":"21","value":"Mac"}]}]}
+]}`
+
+// newCreateMetaServer serves paginated createmeta and the priority scheme, counting requests by path
+func newCreateMetaServer(t *testing.T, requests map[string]int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/rest/api/2/issue/createmeta/PROJ/issuetypes":
+			w.Write([]byte(testCreateMetaIssueTypesJSON))
+		case "/rest/api/2/issue/createmeta/PROJ/issuetypes/10001":
+			w.Write([]byte(testCreateMetaFieldsJSON))
+		case "/rest/api/2/priority":
+			w.Write([]byte(`[{"id":"1","name":"P0"},{"id":"2","name":"P1"},{"id":"3","name":"P2"}]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestValidateIssue(t *testing.T) {
+	hardware := map[string]interface{}{"value": "Laptop", "child": map[string]interface{}{"value": "Mac"}}
+
+	tests := []struct {
+		name       string
+		fields     IssueFields
+		wantErrors []string
+	}{
+		{
+			name: "custom type and priority scheme",
+			fields: IssueFields{
+				Summary:      "Investigate caching",
+				IssueType:    IssueType{Name: "spike"},
+				Priority:     &Priority{Name: "P0"},
+				Components:   []Component{{Name: "backend"}},
+				FixVersions:  []Version{{ID: "100", Name: "2.4.0"}},
+				CustomFields: map[string]interface{}{"customfield_10070": hardware},
+			},
+		},
+		{
+			name:       "unknown issue type lists the project's types",
+			fields:     IssueFields{Summary: "Investigate", IssueType: IssueType{Name: "Story"}},
+			wantErrors: []string{"invalid issue type for project PROJ", "Spike, Sub-task"},
+		},
+		{
+			name:       "missing required field",
+			fields:     IssueFields{Summary: "Investigate", IssueType: IssueType{Name: "Spike"}},
+			wantErrors: []string{"Hardware: field is required for Spike tickets in PROJ"},
+		},
+		{
+			name: "every problem reported together",
+			fields: IssueFields{
+				Summary:      "Investigate",
+				IssueType:    IssueType{Name: "Spike"},
+				Priority:     &Priority{Name: "Highest"},
+				Components:   []Component{{Name: "Backend"}, {Name: "Mobile"}},
+				Labels:       []string{"perf"},
+				CustomFields: map[string]interface{}{"customfield_10070": map[string]interface{}{"value": "Laptop", "child": map[string]interface{}{"value": "PC"}}},
+			},
+			wantErrors: []string{
+				"4 problems",
+				`Components: invalid value "Mobile"`,
+				`Hardware: invalid value "Laptop > PC"`,
+				"labels: field is not on the create screen",
+				`priority: invalid priority "Highest" (must be one of: P0, P1, P2)`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator(NewClient(newCreateMetaServer(t, map[string]int{}).URL, "user@example.com", "token"))
+			tt.fields.Project = Project{Key: "PROJ"}
+
+			err := validator.ValidateIssue(context.Background(), tt.fields)
+			if len(tt.wantErrors) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateIssue() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateIssue() error = nil, want %v", tt.wantErrors)
+			}
+			for _, want := range tt.wantErrors {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error = %v, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatorCachesMetadata(t *testing.T) {
+	requests := map[string]int{}
+	validator := NewValidator(NewClient(newCreateMetaServer(t, requests).URL, "user@example.com", "token"))
+
+	fields := IssueFields{
+		Project:   Project{Key: "PROJ"},
+		Summary:   "Investigate",
+		IssueType: IssueType{Name: "Spike"},
+		Priority:  &Priority{Name: "P1"},
+	}
+	for i := 0; i < 3; i++ {
+		var problems ValidationErrors
+		err := validator.ValidateIssue(context.Background(), fields)
+		if !errors.As(err, &problems) || len(problems) != 1 {
+			t.Fatalf("ValidateIssue() error = %v, want only the missing Hardware field", err)
+		}
+	}
+
+	for path, count := range requests {
+		if count != 1 {
+			t.Errorf("%s requested %d times, want 1", path, count)
+		}
+	}
+	if len(requests) != 3 {
+		t.Errorf("requests = %v, want issue types, fields and priorities", requests)
+	}
+}
+
+func TestValidatorFallsBackToLegacyCreateMeta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/createmeta" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"projects":[{"key":"PROJ","issuetypes":[
+			{"id":"10003","name":"Incident","fields":{
+				"summary":{"name":"Summary","required":true},
+				"priority":{"name":"Priority","required":true,"allowedValues":[{"id":"1","name":"P0"},{"id":"2","name":"P1"}]}
+			}}
+		]}]}`))
+	}))
+	defer server.Close()
+
+	validator := NewValidator(NewClient(server.URL, "user@example.com", "token"))
+	ctx := context.Background()
+
+	if err := validator.ValidateIssueTypeContext(ctx, "PROJ", "incident"); err != nil {
+		t.Errorf("ValidateIssueTypeContext() error = %v", err)
+	}
+	err := validator.ValidateIssue(ctx, IssueFields{
+		Project:   Project{Key: "PROJ"},
+		Summary:   "Outage",
+		IssueType: IssueType{Name: "Incident"},
+		Priority:  &Priority{Name: "P3"},
+	})
+	if err == nil || !strings.Contains(err.Error(), `invalid value "P3" (must be one of: P0, P1)`) {
+		t.Errorf("ValidateIssue() error = %v, want the priority rejected", err)
+	}
+}
@@ -0,0 +1,158 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+)
+
+// VersionService manages project versions and resolves version names for issue fields
+// Each project's version list is cached for the lifetime of the service
+type VersionService struct {
+	client *Client
+
+	mu       sync.Mutex
+	versions map[string][]Version // Keyed by project key
+}
+
+// NewVersionService creates a new version service
+func NewVersionService(client *Client) *VersionService {
+	return &VersionService{client: client, versions: make(map[string][]Version)}
+}
+
+// GetVersions retrieves every version of a project, using the cached list after the first call
+func (s *VersionService) GetVersions(ctx context.Context, projectKey string) ([]Version, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if versions, ok := s.versions[projectKey]; ok {
+		return versions, nil
+	}
+
+	var versions []Version
+	path := fmt.Sprintf("/rest/api/2/project/%s/versions", url.PathEscape(projectKey))
+	if err := s.client.DoContext(ctx, "GET", path, nil, &versions); err != nil {
+		return nil, fmt.Errorf("failed to get versions for project %s: %w", projectKey, err)
+	}
+	s.versions[projectKey] = versions
+	return versions, nil
+}
+
+// FindVersion finds a project version by ID or by name, ignoring case
+func (s *VersionService) FindVersion(ctx context.Context, projectKey, nameOrID string) (*Version, error) {
+	versions, err := s.GetVersions(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := strings.TrimSpace(nameOrID)
+	for i := range versions {
+		if versions[i].ID == wanted {
+			return &versions[i], nil
+		}
+	}
+	for i := range versions {
+		if strings.EqualFold(versions[i].Name, wanted) {
+			return &versions[i], nil
+		}
+	}
+
+	var names []string
+	for _, v := range versions {
+		if !v.Archived {
+			names = append(names, v.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("unknown version %q: project %s has no versions", nameOrID, projectKey)
+	}
+	return nil, fmt.Errorf("unknown version %q in project %s (available: %s)", nameOrID, projectKey, strings.Join(names, ", "))
+}
+
+// ResolveVersions finds each named version of a project, returning references
+// that can be assigned to IssueFields.FixVersions or IssueFields.Versions
+func (s *VersionService) ResolveVersions(ctx context.Context, projectKey string, names []string) ([]Version, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	refs := make([]Version, 0, len(names))
+	for _, name := range names {
+		version, err := s.FindVersion(ctx, projectKey, name)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, Version{ID: version.ID, Name: version.Name})
+	}
+	return refs, nil
+}
+
+// CreateVersion creates a version; version.Project must be set to the project key
+func (s *VersionService) CreateVersion(ctx context.Context, version Version) (*Version, error) {
+	var created Version
+	if err := s.client.DoContext(ctx, "POST", "/rest/api/2/version", version, &created); err != nil {
+		return nil, fmt.Errorf("failed to create version %s: %w", version.Name, err)
+	}
+
+	s.forget(version.Project)
+	return &created, nil
+}
+
+// UpdateVersion changes the given properties of a version, e.g. "description" or "releaseDate"
+func (s *VersionService) UpdateVersion(ctx context.Context, id string, changes map[string]interface{}) (*Version, error) {
+	var updated Version
+	path := fmt.Sprintf("/rest/api/2/version/%s", id)
+	if err := s.client.DoContext(ctx, "PUT", path, changes, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update version %s: %w", id, err)
+	}
+
+	s.forgetAll()
+	return &updated, nil
+}
+
+// ReleaseVersion marks a version released on the given date
+func (s *VersionService) ReleaseVersion(ctx context.Context, id string, date time.Time) (*Version, error) {
+	return s.UpdateVersion(ctx, id, map[string]interface{}{
+		"released":    true,
+		"releaseDate": date.Format(DateFormat),
+	})
+}
+
+// DeleteVersion deletes a version; its issues are left without it
+func (s *VersionService) DeleteVersion(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/rest/api/2/version/%s", id)
+	if err := s.client.DoContext(ctx, "DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete version %s: %w", id, err)
+	}
+
+	s.forgetAll()
+	return nil
+}
+
+// forget drops a project's cached versions
+func (s *VersionService) forget(projectKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.versions, projectKey)
+}
+
+// forgetAll drops every cached version list
+func (s *VersionService) forgetAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.versions = make(map[string][]Version)
+}
+
+// FixVersionJQL returns JQL selecting a project's issues fixed in a version
+func FixVersionJQL(projectKey, version string) string {
+	return jql.New().
+		Where(jql.Eq("project", jql.String(projectKey)), jql.Eq("fixVersion", jql.String(version))).
+		OrderBy("issuetype", jql.Asc).
+		OrderBy("key", jql.Asc).
+		String()
+}
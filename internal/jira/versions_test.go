@@ -0,0 +1,164 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testVersionsJSON = `[
+	{"id":"100","name":"2.3.0","released":true,"releaseDate":"2026-09-01"},
+	{"id":"101","name":"2.4.0","releaseDate":"2026-11-01"},
+	{"id":"102","name":"1.0","archived":true}
+]`
+
+func TestResolveVersions(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/project/PROJ/versions" {
+			t.Errorf("unexpected request path %s", r.URL.Path)
+		}
+		requests++
+		w.Write([]byte(testVersionsJSON))
+	}))
+	defer server.Close()
+
+	service := NewVersionService(NewClient(server.URL, "user@example.com", "token"))
+
+	tests := []struct {
+		name      string
+		versions  []string
+		wantIDs   []string
+		wantError string
+	}{
+		{"by name", []string{"2.4.0"}, []string{"101"}, ""},
+		{"ignoring case and by ID", []string{" 2.3.0", "101"}, []string{"100", "101"}, ""},
+		{"unknown lists unarchived", []string{"9.9"}, nil, "available: 2.3.0, 2.4.0"},
+		{"none", nil, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refs, err := service.ResolveVersions(context.Background(), "PROJ", tt.versions)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveVersions() error = %v", err)
+			}
+			if len(refs) != len(tt.wantIDs) {
+				t.Fatalf("got %d versions, want %d", len(refs), len(tt.wantIDs))
+			}
+			for i, ref := range refs {
+				if ref.ID != tt.wantIDs[i] {
+					t.Errorf("version %d ID = %s, want %s", i, ref.ID, tt.wantIDs[i])
+				}
+			}
+		})
+	}
+
+	if requests != 1 {
+		t.Errorf("versions fetched %d times, want 1", requests)
+	}
+}
+
+func TestCreateVersionRefreshesCache(t *testing.T) {
+	listed := 0
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/2/project/PROJ/versions":
+			listed++
+			if listed == 1 {
+				w.Write([]byte(`[]`))
+				return
+			}
+			w.Write([]byte(`[{"id":"103","name":"2.5.0"}]`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/2/version":
+			data, _ := io.ReadAll(r.Body)
+			json.Unmarshal(data, &created)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"103","name":"2.5.0"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	service := NewVersionService(NewClient(server.URL, "user@example.com", "token"))
+	ctx := context.Background()
+
+	if _, err := service.FindVersion(ctx, "PROJ", "2.5.0"); err == nil {
+		t.Fatal("expected an error before the version exists")
+	}
+	if _, err := service.CreateVersion(ctx, Version{Name: "2.5.0", Project: "PROJ", ReleaseDate: "2026-12-01"}); err != nil {
+		t.Fatalf("CreateVersion() error = %v", err)
+	}
+	if created["project"] != "PROJ" || created["releaseDate"] != "2026-12-01" || created["released"] != nil {
+		t.Errorf("request body = %v", created)
+	}
+	if _, err := service.FindVersion(ctx, "PROJ", "2.5.0"); err != nil {
+		t.Errorf("FindVersion() after create error = %v", err)
+	}
+}
+
+func TestReleaseVersion(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/api/2/version/101" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &got)
+		w.Write([]byte(`{"id":"101","name":"2.4.0","released":true}`))
+	}))
+	defer server.Close()
+
+	service := NewVersionService(NewClient(server.URL, "user@example.com", "token"))
+	date := time.Date(2026, 10, 30, 0, 0, 0, 0, time.UTC)
+	if _, err := service.ReleaseVersion(context.Background(), "101", date); err != nil {
+		t.Fatalf("ReleaseVersion() error = %v", err)
+	}
+
+	if got["released"] != true || got["releaseDate"] != "2026-10-30" {
+		t.Errorf("request body = %v", got)
+	}
+}
+
+func TestCreateIssueSendsVersions(t *testing.T) {
+	var body map[string]map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"1","key":"PROJ-1"}`))
+	}))
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	_, err := service.CreateIssueWithFieldsContext(context.Background(), IssueFields{
+		Project:     Project{Key: "PROJ"},
+		Summary:     "Crash on save",
+		IssueType:   IssueType{Name: "Bug"},
+		FixVersions: []Version{{ID: "101", Name: "2.4.0"}},
+		Versions:    []Version{{ID: "100", Name: "2.3.0"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateIssueWithFieldsContext() error = %v", err)
+	}
+
+	if got := string(body["fields"]["fixVersions"]); got != `[{"id":"101","name":"2.4.0"}]` {
+		t.Errorf("fixVersions = %s", got)
+	}
+	if got := string(body["fields"]["versions"]); got != `[{"id":"100","name":"2.3.0"}]` {
+		t.Errorf("versions = %s", got)
+	}
+}
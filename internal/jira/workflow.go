@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+)
+
+// maxWorkflowStatuses bounds how many statuses FindTransitionPath explores
+const maxWorkflowStatuses = 50
+
+// TransitionStep is one transition on a path through an issue's workflow
+type TransitionStep struct {
+	From       string // Status the transition starts from
+	Transition Transition
+}
+
+// To returns the status the step ends in
+func (s TransitionStep) To() string {
+	return s.Transition.To.Name
+}
+
+// RequiredFields returns the names of fields the transition's screen requires that
+// have no default and are not among set, which is keyed by field ID
+func (s TransitionStep) RequiredFields(set map[string]bool) []string {
+	var names []string
+	for _, id := range sortedFieldIDs(s.Transition.Fields) {
+		meta := s.Transition.Fields[id]
+		if meta.Required && !meta.HasDefaultValue && !set[id] {
+			names = append(names, meta.Name)
+		}
+	}
+	return names
+}
+
+// FindTransitionPath finds the shortest sequence of transitions that takes an issue
+// to the named status, returning an empty path when it is already there
+//
+// JIRA only lists the transitions available to an issue from its current status, so
+// transitions out of the other statuses are learned from an issue of the same project
+// and type that is currently in that status. Statuses no such issue is in are treated
+// as dead ends, and transitions restricted by conditions may differ between issues,
+// so TransitionPath checks each step again before running it.
+func (s *IssueService) FindTransitionPath(ctx context.Context, key, status string) ([]TransitionStep, error) {
+	issue, err := s.GetIssueWithOptions(ctx, key, GetOptions{Fields: []string{"status", "issuetype", "project"}})
+	if err != nil {
+		return nil, err
+	}
+	if issue.Fields.Status == nil {
+		return nil, fmt.Errorf("failed to get the status of %s", key)
+	}
+
+	current := issue.Fields.Status.Name
+	if strings.EqualFold(current, status) {
+		return nil, nil
+	}
+
+	// Breadth-first search, remembering the step that first reached each status
+	reachedBy := map[string]TransitionStep{}
+	visited := map[string]bool{strings.ToLower(current): true}
+	reachable := []string{}
+	queue := []string{current}
+	for explored := 0; len(queue) > 0 && explored < maxWorkflowStatuses; explored++ {
+		from := queue[0]
+		queue = queue[1:]
+
+		var transitions []Transition
+		if from == current {
+			transitions, err = s.GetTransitionsContext(ctx, key)
+		} else {
+			transitions, err = s.sampleTransitions(ctx, key, issue.Fields.Project.Key, issue.Fields.IssueType.Name, from)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range transitions {
+			to := strings.ToLower(t.To.Name)
+			if visited[to] {
+				continue
+			}
+			visited[to] = true
+			reachedBy[to] = TransitionStep{From: from, Transition: t}
+			reachable = append(reachable, t.To.Name)
+
+			if to == strings.ToLower(strings.TrimSpace(status)) {
+				return buildTransitionPath(reachedBy, current, to), nil
+			}
+			queue = append(queue, t.To.Name)
+		}
+	}
+
+	if len(reachable) == 0 {
+		return nil, fmt.Errorf("no transitions are available for %s from %s", key, current)
+	}
+	return nil, fmt.Errorf("no path from %s to %s in the workflow of %s (reachable: %s)", current, status, key, strings.Join(reachable, ", "))
+}
+
+// buildTransitionPath follows the steps back from the target to the starting status
+func buildTransitionPath(reachedBy map[string]TransitionStep, start, target string) []TransitionStep {
+	var path []TransitionStep
+	for status := target; !strings.EqualFold(status, start); {
+		step := reachedBy[status]
+		path = append([]TransitionStep{step}, path...)
+		status = strings.ToLower(step.From)
+	}
+	return path
+}
+
+// sampleTransitions returns the transitions out of a status, as available to another
+// issue of the project and type currently in it; nil when there is no such issue
+func (s *IssueService) sampleTransitions(ctx context.Context, key, projectKey, issueType, status string) ([]Transition, error) {
+	query := jql.New().Where(
+		jql.Eq("project", jql.String(projectKey)),
+		jql.Eq("issuetype", jql.String(issueType)),
+		jql.Eq("status", jql.String(status)),
+		jql.NotEq("key", jql.String(key)),
+	)
+	resp, err := s.client.GetIssueByJQLContext(ctx, query.String(), 0, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find an issue in status %s: %w", status, err)
+	}
+	if len(resp.Issues) == 0 {
+		return nil, nil
+	}
+	return s.GetTransitionsContext(ctx, resp.Issues[0].Key)
+}
+
+// TransitionPath runs the steps of a path found by FindTransitionPath in order,
+// calling done (if set) after each one
+//
+// Before each step the issue's transitions are fetched again, and the step fails if
+// none leads to its status. The comment is added on the last step; the resolution and
+// fields are sent on the last step and on every earlier step whose screen has them.
+func (s *IssueService) TransitionPath(ctx context.Context, key string, path []TransitionStep, opts TransitionOptions, done func(TransitionStep)) error {
+	for i, step := range path {
+		transitions, err := s.GetTransitionsContext(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		transition := matchTransition(transitions, step)
+		if transition == nil {
+			return fmt.Errorf("cannot transition %s from %s to %s: the transition is not available", key, step.From, step.To())
+		}
+
+		stepOpts := opts
+		if i < len(path)-1 {
+			stepOpts = opts.forScreen(transition.Fields)
+		}
+		if err := s.TransitionIssueWithOptions(ctx, key, transition.ID, stepOpts); err != nil {
+			return err
+		}
+
+		if done != nil {
+			done(step)
+		}
+	}
+	return nil
+}
+
+// matchTransition finds the transition for a step, preferring the planned one
+func matchTransition(transitions []Transition, step TransitionStep) *Transition {
+	var match *Transition
+	for i := range transitions {
+		if !strings.EqualFold(transitions[i].To.Name, step.To()) {
+			continue
+		}
+		if transitions[i].ID == step.Transition.ID {
+			return &transitions[i]
+		}
+		if match == nil {
+			match = &transitions[i]
+		}
+	}
+	return match
+}
+
+// forScreen keeps only the resolution and fields that are on a transition's screen
+func (o TransitionOptions) forScreen(screen map[string]FieldMeta) TransitionOptions {
+	var result TransitionOptions
+	if _, ok := screen["resolution"]; ok {
+		result.Resolution = o.Resolution
+	}
+	for id, value := range o.Fields {
+		if _, ok := screen[id]; ok {
+			if result.Fields == nil {
+				result.Fields = make(map[string]interface{})
+			}
+			result.Fields[id] = value
+		}
+	}
+	return result
+}
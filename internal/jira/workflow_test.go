@@ -0,0 +1,165 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeWorkflowServer serves a To Do -> In Progress -> In Review -> Done workflow.
+// PROJ-1 moves through it as transitions are posted; PROJ-2 and PROJ-3 are samples
+// in the intermediate statuses, found through JQL
+type fakeWorkflowServer struct {
+	mu          sync.Mutex
+	status      string
+	transitions []map[string]interface{} // Requests posted for PROJ-1
+}
+
+// workflowTransitions lists the transitions out of each status
+var workflowTransitions = map[string]string{
+	"To Do":       `[{"id":"11","name":"Start","to":{"name":"In Progress"}}]`,
+	"In Progress": `[{"id":"21","name":"Stop","to":{"name":"To Do"}},{"id":"31","name":"Review","to":{"name":"In Review"},"fields":{"resolution":{"name":"Resolution","required":false}}}]`,
+	"In Review":   `[{"id":"41","name":"Approve","to":{"name":"Done"},"fields":{"resolution":{"name":"Resolution","required":true},"customfield_1":{"name":"Reviewer","required":true}}}]`,
+	"Done":        `[{"id":"51","name":"Reopen","to":{"name":"To Do"}}]`,
+}
+
+func (f *fakeWorkflowServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/rest/api/2/issue/PROJ-1":
+		fmt.Fprintf(w, `{"key":"PROJ-1","fields":{"project":{"key":"PROJ"},"issuetype":{"name":"Story"},"status":{"name":%q}}}`, f.status)
+	case r.URL.Path == "/rest/api/2/search":
+		jql := r.URL.Query().Get("jql")
+		switch {
+		case strings.Contains(jql, `status = "In Progress"`):
+			w.Write([]byte(`{"issues":[{"key":"PROJ-2"}]}`))
+		case strings.Contains(jql, `status = "In Review"`):
+			w.Write([]byte(`{"issues":[{"key":"PROJ-3"}]}`))
+		default:
+			w.Write([]byte(`{"issues":[]}`))
+		}
+	case r.URL.Path == "/rest/api/2/issue/PROJ-1/transitions" && r.Method == "POST":
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		f.transitions = append(f.transitions, req)
+		id := req["transition"].(map[string]interface{})["id"]
+		var available []Transition
+		json.Unmarshal([]byte(workflowTransitions[f.status]), &available)
+		for _, t := range available {
+			if t.ID == id {
+				f.status = t.To.Name
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasSuffix(r.URL.Path, "/transitions"):
+		status := map[string]string{
+			"/rest/api/2/issue/PROJ-1/transitions": f.status,
+			"/rest/api/2/issue/PROJ-2/transitions": "In Progress",
+			"/rest/api/2/issue/PROJ-3/transitions": "In Review",
+		}[r.URL.Path]
+		fmt.Fprintf(w, `{"transitions":%s}`, workflowTransitions[status])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestFindTransitionPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    string
+		target    string
+		wantPath  []string
+		wantError string
+	}{
+		{"direct", "To Do", "In Progress", []string{"To Do -> In Progress"}, ""},
+		{"through sample issues", "To Do", "done", []string{"To Do -> In Progress", "In Progress -> In Review", "In Review -> Done"}, ""},
+		{"already there", "Done", "Done", nil, ""},
+		{"unknown status", "To Do", "Blocked", nil, "reachable: In Progress, In Review, Done"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(&fakeWorkflowServer{status: tt.status})
+			defer server.Close()
+
+			service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+			path, err := service.FindTransitionPath(context.Background(), "PROJ-1", tt.target)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("FindTransitionPath() error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FindTransitionPath() error = %v", err)
+			}
+
+			var got []string
+			for _, step := range path {
+				got = append(got, step.From+" -> "+step.To())
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.wantPath) {
+				t.Errorf("FindTransitionPath() = %v, want %v", got, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestTransitionPath(t *testing.T) {
+	fake := &fakeWorkflowServer{status: "To Do"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	service := NewIssueService(NewClient(server.URL, "user@example.com", "token"))
+	path, err := service.FindTransitionPath(context.Background(), "PROJ-1", "Done")
+	if err != nil {
+		t.Fatalf("FindTransitionPath() error = %v", err)
+	}
+
+	if required := path[2].RequiredFields(map[string]bool{"resolution": true}); fmt.Sprint(required) != "[Reviewer]" {
+		t.Errorf("RequiredFields() = %v, want [Reviewer]", required)
+	}
+
+	var done []string
+	opts := TransitionOptions{
+		Comment:    "Shipped",
+		Resolution: "Fixed",
+		Fields:     map[string]interface{}{"customfield_1": "jane"},
+	}
+	err = service.TransitionPath(context.Background(), "PROJ-1", path, opts, func(step TransitionStep) {
+		done = append(done, step.To())
+	})
+	if err != nil {
+		t.Fatalf("TransitionPath() error = %v", err)
+	}
+	if fake.status != "Done" || fmt.Sprint(done) != "[In Progress In Review Done]" {
+		t.Fatalf("status = %s after %v, want Done after every step", fake.status, done)
+	}
+
+	// The resolution goes only on screens that have it, the comment only on the last step
+	for i, want := range []struct {
+		resolution bool
+		reviewer   bool
+		comment    bool
+	}{{false, false, false}, {true, false, false}, {true, true, true}} {
+		req := fake.transitions[i]
+		fields, _ := req["fields"].(map[string]interface{})
+		_, hasResolution := fields["resolution"]
+		_, hasReviewer := fields["customfield_1"]
+		_, hasComment := req["update"]
+		if hasResolution != want.resolution || hasReviewer != want.reviewer || hasComment != want.comment {
+			t.Errorf("transition %d request = %v, want resolution %t, reviewer %t, comment %t", i+1, req, want.resolution, want.reviewer, want.comment)
+		}
+	}
+
+	if resolution := fake.transitions[2]["fields"].(map[string]interface{})["resolution"]; fmt.Sprint(resolution) != "map[name:Fixed]" {
+		t.Errorf("resolution = %v, want {name: Fixed}", resolution)
+	}
+}
@@ -0,0 +1,215 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StartedTimeFormat is the timestamp format JIRA uses for worklog start times
+const StartedTimeFormat = "2006-01-02T15:04:05.000-0700"
+
+// Default JIRA time tracking units: a working day is 8 hours and a week is 5 days
+const (
+	SecondsPerHour = 3600
+	SecondsPerDay  = 8 * SecondsPerHour
+	SecondsPerWeek = 5 * SecondsPerDay
+)
+
+// Worklog represents time logged against a JIRA issue
+type Worklog struct {
+	ID               string `json:"id"`
+	Self             string `json:"self,omitempty"`
+	IssueID          string `json:"issueId,omitempty"`
+	Author           *User  `json:"author,omitempty"`
+	UpdateAuthor     *User  `json:"updateAuthor,omitempty"`
+	Comment          string `json:"comment,omitempty"`
+	Started          string `json:"started"`
+	TimeSpent        string `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds"`
+}
+
+// WorklogPage is a page of worklogs returned by the worklog endpoint
+type WorklogPage struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	Worklogs   []Worklog `json:"worklogs"`
+}
+
+// worklogPayload is the request body for adding or updating a worklog
+type worklogPayload struct {
+	Comment          interface{} `json:"comment,omitempty"`
+	Started          string      `json:"started"`
+	TimeSpentSeconds int         `json:"timeSpentSeconds"`
+}
+
+// StartedTime parses the worklog's start time
+func (w *Worklog) StartedTime() (time.Time, error) {
+	t, err := time.Parse(StartedTimeFormat, w.Started)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse worklog start time %q: %w", w.Started, err)
+	}
+	return t, nil
+}
+
+// UnmarshalJSON decodes a worklog, converting its wiki or ADF comment to Markdown
+func (w *Worklog) UnmarshalJSON(data []byte) error {
+	type plainWorklog Worklog
+	aux := struct {
+		*plainWorklog
+		Comment json.RawMessage `json:"comment"`
+	}{plainWorklog: (*plainWorklog)(w)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	comment, err := decodeRichText(aux.Comment)
+	if err != nil {
+		return err
+	}
+	w.Comment = comment
+	return nil
+}
+
+// timeSpentPattern matches one JIRA duration component, e.g. "1h" or "30m"
+var timeSpentPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*([wdhm])`)
+
+// ParseTimeSpent parses a JIRA-style duration such as "1h30m", "1h 30m", "2d" or
+// "1.5h" into seconds, using JIRA's default 8-hour day and 5-day week
+func ParseTimeSpent(s string) (int, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(s))
+	if trimmed == "" {
+		return 0, fmt.Errorf("time spent is required")
+	}
+
+	matches := timeSpentPattern.FindAllStringSubmatchIndex(trimmed, -1)
+	if len(matches) == 0 {
+		return 0, fmt.Errorf("invalid time spent: %s (use e.g. 1h30m, 45m, 2d)", s)
+	}
+
+	// Everything other than the matched components must be whitespace
+	rest := timeSpentPattern.ReplaceAllString(trimmed, "")
+	if strings.TrimSpace(rest) != "" {
+		return 0, fmt.Errorf("invalid time spent: %s (use e.g. 1h30m, 45m, 2d)", s)
+	}
+
+	units := map[string]float64{"w": SecondsPerWeek, "d": SecondsPerDay, "h": SecondsPerHour, "m": 60}
+	total := 0.0
+	for _, m := range matches {
+		value, err := strconv.ParseFloat(trimmed[m[2]:m[3]], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid time spent: %s", s)
+		}
+		total += value * units[trimmed[m[4]:m[5]]]
+	}
+
+	seconds := int(total)
+	if seconds < 60 {
+		return 0, fmt.Errorf("time spent must be at least 1m: %s", s)
+	}
+	return seconds, nil
+}
+
+// FormatTimeSpent formats seconds as hours and minutes, e.g. "9h 30m"
+// Days are not used so that totals stay comparable across reports
+func FormatTimeSpent(seconds int) string {
+	hours := seconds / SecondsPerHour
+	minutes := (seconds % SecondsPerHour) / 60
+
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// WorklogService handles JIRA time tracking operations
+type WorklogService struct {
+	client *Client
+}
+
+// NewWorklogService creates a new worklog service
+func NewWorklogService(client *Client) *WorklogService {
+	return &WorklogService{client: client}
+}
+
+// newWorklogPayload builds an add/update request body
+func (s *WorklogService) newWorklogPayload(started time.Time, timeSpentSeconds int, comment string) worklogPayload {
+	req := worklogPayload{
+		Started:          started.Format(StartedTimeFormat),
+		TimeSpentSeconds: timeSpentSeconds,
+	}
+	if comment != "" {
+		req.Comment = s.client.richText(comment)
+	}
+	return req
+}
+
+// AddWorklog logs time against an issue
+func (s *WorklogService) AddWorklog(ctx context.Context, key string, started time.Time, timeSpentSeconds int, comment string) (*Worklog, error) {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/worklog", key)
+
+	var worklog Worklog
+	if err := s.client.DoContext(ctx, "POST", path, s.newWorklogPayload(started, timeSpentSeconds, comment), &worklog); err != nil {
+		return nil, fmt.Errorf("failed to log time on %s: %w", key, err)
+	}
+	return &worklog, nil
+}
+
+// GetWorklogs retrieves every worklog on an issue
+func (s *WorklogService) GetWorklogs(ctx context.Context, key string) ([]Worklog, error) {
+	var worklogs []Worklog
+	for {
+		path := fmt.Sprintf("/rest/api/2/issue/%s/worklog?startAt=%d&maxResults=%d", key, len(worklogs), DefaultSearchPageSize)
+
+		var page WorklogPage
+		if err := s.client.DoContext(ctx, "GET", path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get worklogs for %s: %w", key, err)
+		}
+
+		worklogs = append(worklogs, page.Worklogs...)
+		if len(page.Worklogs) == 0 || len(worklogs) >= page.Total {
+			return worklogs, nil
+		}
+	}
+}
+
+// GetWorklog retrieves a single worklog on an issue
+func (s *WorklogService) GetWorklog(ctx context.Context, key, id string) (*Worklog, error) {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/worklog/%s", key, id)
+
+	var worklog Worklog
+	if err := s.client.DoContext(ctx, "GET", path, nil, &worklog); err != nil {
+		return nil, fmt.Errorf("failed to get worklog %s on %s: %w", id, key, err)
+	}
+	return &worklog, nil
+}
+
+// UpdateWorklog replaces the start time, time spent and comment of a worklog
+func (s *WorklogService) UpdateWorklog(ctx context.Context, key, id string, started time.Time, timeSpentSeconds int, comment string) (*Worklog, error) {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/worklog/%s", key, id)
+
+	var worklog Worklog
+	if err := s.client.DoContext(ctx, "PUT", path, s.newWorklogPayload(started, timeSpentSeconds, comment), &worklog); err != nil {
+		return nil, fmt.Errorf("failed to update worklog %s on %s: %w", id, key, err)
+	}
+	return &worklog, nil
+}
+
+// DeleteWorklog deletes a worklog from an issue
+func (s *WorklogService) DeleteWorklog(ctx context.Context, key, id string) error {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/worklog/%s", key, id)
+	if err := s.client.DoContext(ctx, "DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete worklog %s on %s: %w", id, key, err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseTimeSpent(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  int
+		wantError bool
+	}{
+		{"1h30m", 5400, false},
+		{"1h 30m", 5400, false},
+		{"45m", 2700, false},
+		{"1.5h", 5400, false},
+		{"2d", 2 * 8 * 3600, false},
+		{"1w 1d", 6 * 8 * 3600, false},
+		{" 2H ", 7200, false},
+		{"", 0, true},
+		{"90", 0, true},
+		{"1h30", 0, true},
+		{"1x", 0, true},
+		{"0m", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTimeSpent(tt.input)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("ParseTimeSpent(%q) error = %v, wantError %v", tt.input, err, tt.wantError)
+			}
+			if got != tt.expected {
+				t.Errorf("ParseTimeSpent(%q) = %d, want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatTimeSpent(t *testing.T) {
+	tests := []struct {
+		seconds  int
+		expected string
+	}{
+		{5400, "1h 30m"},
+		{7200, "2h"},
+		{2700, "45m"},
+		{0, "0m"},
+		{10 * 3600, "10h"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatTimeSpent(tt.seconds); got != tt.expected {
+			t.Errorf("FormatTimeSpent(%d) = %q, want %q", tt.seconds, got, tt.expected)
+		}
+	}
+}
+
+func TestAddWorklog(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &gotBody)
+		w.Write([]byte(`{"id":"100","started":"2026-10-16T09:00:00.000+0000","timeSpentSeconds":5400,"timeSpent":"1h 30m"}`))
+	}))
+	defer server.Close()
+
+	service := NewWorklogService(NewClient(server.URL, "user@example.com", "token"))
+	started := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	worklog, err := service.AddWorklog(context.Background(), "PROJ-1", started, 5400, "Code review")
+	if err != nil {
+		t.Fatalf("AddWorklog() error = %v", err)
+	}
+
+	if gotPath != "/rest/api/2/issue/PROJ-1/worklog" {
+		t.Errorf("request path = %s", gotPath)
+	}
+	if gotBody["started"] != "2026-10-16T09:00:00.000+0000" {
+		t.Errorf("started = %v", gotBody["started"])
+	}
+	if gotBody["timeSpentSeconds"] != float64(5400) {
+		t.Errorf("timeSpentSeconds = %v", gotBody["timeSpentSeconds"])
+	}
+	if gotBody["comment"] != "Code review" {
+		t.Errorf("comment = %v", gotBody["comment"])
+	}
+
+	startedTime, err := worklog.StartedTime()
+	if err != nil {
+		t.Fatalf("StartedTime() error = %v", err)
+	}
+	if !startedTime.Equal(started) {
+		t.Errorf("StartedTime() = %v, want %v", startedTime, started)
+	}
+}
+
+func TestAddWorklogOmitsEmptyComment(t *testing.T) {
+	var gotBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &gotBody)
+		w.Write([]byte(`{"id":"100"}`))
+	}))
+	defer server.Close()
+
+	service := NewWorklogService(NewClient(server.URL, "user@example.com", "token"))
+	if _, err := service.AddWorklog(context.Background(), "PROJ-1", time.Now(), 60, ""); err != nil {
+		t.Fatalf("AddWorklog() error = %v", err)
+	}
+
+	if _, ok := gotBody["comment"]; ok {
+		t.Errorf("comment should be omitted, got %v", gotBody["comment"])
+	}
+}
+
+func TestGetWorklog(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(`{"id":"100","started":"2024-03-01T09:30:00.000+0000","timeSpentSeconds":5400}`))
+	}))
+	defer server.Close()
+
+	service := NewWorklogService(NewClient(server.URL, "user@example.com", "token"))
+	worklog, err := service.GetWorklog(context.Background(), "PROJ-1", "100")
+	if err != nil {
+		t.Fatalf("GetWorklog() error = %v", err)
+	}
+
+	if gotPath != "/rest/api/2/issue/PROJ-1/worklog/100" {
+		t.Errorf("request path = %s", gotPath)
+	}
+	if worklog.TimeSpentSeconds != 5400 {
+		t.Errorf("TimeSpentSeconds = %d, want 5400", worklog.TimeSpentSeconds)
+	}
+	if started, err := worklog.StartedTime(); err != nil || !started.Equal(time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("StartedTime() = %v, %v", started, err)
+	}
+}
@@ -0,0 +1,355 @@
+package jql
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// issueKeyPattern matches issue keys such as PROJ-123, which compare by project then number
+var issueKeyPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)-(\d+)$`)
+
+// priorityRanks orders the default JIRA priorities, higher is more urgent
+var priorityRanks = map[string]int{
+	"lowest": 1, "trivial": 1,
+	"low": 2, "minor": 2,
+	"medium": 3, "major": 3,
+	"high": 4, "critical": 4,
+	"highest": 5, "blocker": 5,
+}
+
+// relativeUnits are the units of relative dates such as -7d
+var relativeUnits = map[byte]time.Duration{
+	'w': 7 * 24 * time.Hour,
+	'd': 24 * time.Hour,
+	'h': time.Hour,
+	'm': time.Minute,
+}
+
+// Record is something a parsed statement can be evaluated against, e.g. a tracked ticket
+type Record interface {
+	// FieldValues returns the values of field, none when it is empty, or false
+	// when the record has no such field. Dates are formatted with DateTimeLayout.
+	FieldValues(field string) ([]string, bool)
+}
+
+// Env supplies the values of functions during evaluation
+type Env struct {
+	CurrentUser string    // Value of currentUser()
+	Now         time.Time // Value of now(); the current time when zero
+}
+
+func (env Env) now() time.Time {
+	if env.Now.IsZero() {
+		return time.Now()
+	}
+	return env.Now
+}
+
+// Validate checks that r has every field the statement names
+func (s *Statement) Validate(r Record) error {
+	var fields []string
+	walk(s.Where, func(c *Condition) {
+		fields = append(fields, c.Field)
+	})
+	for _, key := range s.OrderBy {
+		fields = append(fields, key.Field)
+	}
+	for _, field := range fields {
+		if _, ok := r.FieldValues(field); !ok {
+			return fmt.Errorf("unknown field: %s", field)
+		}
+	}
+	return nil
+}
+
+// walk calls fn for every condition in x
+func walk(x Expr, fn func(*Condition)) {
+	switch e := x.(type) {
+	case *BinaryExpr:
+		walk(e.Left, fn)
+		walk(e.Right, fn)
+	case *NotExpr:
+		walk(e.X, fn)
+	case *Condition:
+		fn(e)
+	}
+}
+
+// Match reports whether r matches the statement's condition
+//
+// As in JIRA, values compare without regard to case, != and NOT IN never match
+// empty fields, and a multi-value field matches = when any of its values does.
+// ~ matches values containing the text. Dates, priorities, issue keys and
+// numbers compare by their meaning rather than as text.
+func (s *Statement) Match(r Record, env Env) (bool, error) {
+	if s.Where == nil {
+		return true, nil
+	}
+	return eval(s.Where, r, env)
+}
+
+func eval(x Expr, r Record, env Env) (bool, error) {
+	switch e := x.(type) {
+	case *BinaryExpr:
+		left, err := eval(e.Left, r, env)
+		if err != nil {
+			return false, err
+		}
+		if left == (e.Op == logicalOr) {
+			return left, nil
+		}
+		return eval(e.Right, r, env)
+	case *NotExpr:
+		matched, err := eval(e.X, r, env)
+		return !matched, err
+	case *Condition:
+		return evalCondition(e, r, env)
+	}
+	return false, fmt.Errorf("unsupported expression: %s", x)
+}
+
+func evalCondition(c *Condition, r Record, env Env) (bool, error) {
+	values, ok := r.FieldValues(c.Field)
+	if !ok {
+		return false, fmt.Errorf("unknown field: %s", c.Field)
+	}
+
+	operands := make([]Operand, len(c.Operands))
+	for i, operand := range c.Operands {
+		resolved, err := resolveOperand(operand, env)
+		if err != nil {
+			return false, err
+		}
+		operands[i] = resolved
+	}
+
+	now := env.now()
+	matches := func(operand Operand, test func(value string) bool) bool {
+		if operand.Kind == OperandEmpty {
+			return len(values) == 0
+		}
+		for _, value := range values {
+			if test(value) {
+				return true
+			}
+		}
+		return false
+	}
+	equals := func(operand Operand) bool {
+		return matches(operand, func(value string) bool {
+			return compareValues(c.Field, value, operand.Text, now) == 0
+		})
+	}
+	anyEquals := func() bool {
+		for _, operand := range operands {
+			if equals(operand) {
+				return true
+			}
+		}
+		return false
+	}
+	operand := operands[0]
+
+	switch c.Op {
+	case OpEq, OpIs:
+		return equals(operand), nil
+	case OpNotEq, OpIsNot:
+		if operand.Kind == OperandEmpty {
+			return len(values) > 0, nil
+		}
+		return len(values) > 0 && !equals(operand), nil
+	case OpIn:
+		return anyEquals(), nil
+	case OpNotIn:
+		return len(values) > 0 && !anyEquals(), nil
+	case OpContains, OpNotMatch:
+		text := strings.ToLower(operand.Text)
+		contains := matches(operand, func(value string) bool {
+			return strings.Contains(strings.ToLower(value), text)
+		})
+		if c.Op == OpNotMatch {
+			return len(values) > 0 && !contains, nil
+		}
+		return contains, nil
+	case OpLt, OpLte, OpGt, OpGte:
+		return matches(operand, func(value string) bool {
+			cmp := compareValues(c.Field, value, operand.Text, now)
+			switch c.Op {
+			case OpLt:
+				return cmp < 0
+			case OpLte:
+				return cmp <= 0
+			case OpGt:
+				return cmp > 0
+			}
+			return cmp >= 0
+		}), nil
+	}
+	return false, fmt.Errorf("unsupported operator: %s", c.Op)
+}
+
+// resolveOperand replaces a function call with its value
+func resolveOperand(operand Operand, env Env) (Operand, error) {
+	if operand.Kind != OperandFunc {
+		return operand, nil
+	}
+
+	now := env.now()
+	var text string
+	switch operand.Text {
+	case "currentUser":
+		if env.CurrentUser == "" {
+			return Operand{}, fmt.Errorf("currentUser() is unknown: no user is configured")
+		}
+		text = env.CurrentUser
+	case "now":
+		text = now.Format(DateTimeLayout)
+	case "startOfDay":
+		text = now.Format(DateLayout)
+	case "endOfDay":
+		text = now.Format(DateLayout) + " 23:59"
+	default:
+		return Operand{}, fmt.Errorf("unsupported function %s()", operand.Text)
+	}
+	return Operand{Kind: OperandValue, Text: text}, nil
+}
+
+// compareValues compares two values of field, returning -1, 0 or 1
+// Priorities compare by urgency, dates by time, issue keys by project and
+// number, and numbers numerically; anything else compares as text ignoring case
+func compareValues(field, a, b string, now time.Time) int {
+	if strings.EqualFold(field, "priority") {
+		ra, okA := priorityRanks[strings.ToLower(a)]
+		rb, okB := priorityRanks[strings.ToLower(b)]
+		if okA && okB {
+			return compareInts(ra, rb)
+		}
+	}
+
+	if ta, ok := parseTime(a, now); ok {
+		if tb, ok := parseTime(b, now); ok {
+			return ta.Compare(tb)
+		}
+	}
+
+	if ka := issueKeyPattern.FindStringSubmatch(a); ka != nil {
+		if kb := issueKeyPattern.FindStringSubmatch(b); kb != nil {
+			if cmp := strings.Compare(strings.ToUpper(ka[1]), strings.ToUpper(kb[1])); cmp != 0 {
+				return cmp
+			}
+			na, _ := strconv.Atoi(ka[2])
+			nb, _ := strconv.Atoi(kb[2])
+			return compareInts(na, nb)
+		}
+	}
+
+	if fa, err := strconv.ParseFloat(a, 64); err == nil {
+		if fb, err := strconv.ParseFloat(b, 64); err == nil {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// parseTime parses a date, a date and time, or a period relative to now such as -7d
+func parseTime(value string, now time.Time) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{DateTimeLayout, DateLayout, "2006/01/02 15:04", "2006/01/02", time.RFC3339} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, true
+		}
+	}
+
+	if !relativeDatePattern.MatchString(value) {
+		return time.Time{}, false
+	}
+	sign := time.Duration(1)
+	t := now
+	for _, part := range strings.Fields(strings.NewReplacer("-", " -", "+", " +").Replace(value)) {
+		switch part[0] {
+		case '-':
+			sign, part = -1, part[1:]
+		case '+':
+			sign, part = 1, part[1:]
+		}
+		n, _ := strconv.Atoi(part[:len(part)-1])
+		t = t.Add(sign * time.Duration(n) * relativeUnits[part[len(part)-1]])
+	}
+	return t, true
+}
+
+// Sort sorts records by the statement's ORDER BY, keeping the order of equal records
+// Without a direction priority sorts most urgent first and other fields ascending;
+// empty fields sort last either way
+func Sort[R Record](s *Statement, records []R, env Env) {
+	if len(s.OrderBy) == 0 {
+		return
+	}
+	now := env.now()
+	sort.SliceStable(records, func(i, j int) bool {
+		for _, key := range s.OrderBy {
+			a, _ := records[i].FieldValues(key.Field)
+			b, _ := records[j].FieldValues(key.Field)
+			switch {
+			case len(a) == 0 && len(b) == 0:
+				continue
+			case len(a) == 0:
+				return false
+			case len(b) == 0:
+				return true
+			}
+
+			cmp := compareValues(key.Field, a[0], b[0], now)
+			if cmp == 0 {
+				continue
+			}
+			desc := key.Direction == Desc || key.Direction == "" && strings.EqualFold(key.Field, "priority")
+			return (cmp < 0) != desc
+		}
+		return false
+	})
+}
+
+// Filter returns the records matching the statement, sorted by its ORDER BY
+// Fields are checked against the zero R first, so a misspelt field is reported
+// even when there are no records
+func Filter[R Record](s *Statement, records []R, env Env) ([]R, error) {
+	var zero R
+	if err := s.Validate(zero); err != nil {
+		return nil, err
+	}
+
+	matched := make([]R, 0, len(records))
+	for _, r := range records {
+		ok, err := s.Match(r, env)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, r)
+		}
+	}
+	Sort(s, matched, env)
+	return matched, nil
+}
@@ -0,0 +1,104 @@
+package jql
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeRecord is a record whose fields are given by a map; a comma separates multiple values
+type fakeRecord map[string]string
+
+func (r fakeRecord) FieldValues(field string) ([]string, bool) {
+	switch field {
+	case "key", "status", "assignee", "priority", "summary", "created", "labels":
+	default:
+		return nil, false
+	}
+	if r[field] == "" {
+		return nil, true
+	}
+	return strings.Split(r[field], ","), true
+}
+
+func TestFilter(t *testing.T) {
+	records := []fakeRecord{
+		{"key": "PROJ-2", "status": "To Do", "assignee": "alice@example.com", "priority": "High", "summary": "Fix login", "created": "2024-03-01 09:00", "labels": "auth,backend"},
+		{"key": "PROJ-10", "status": "Done", "assignee": "bob@example.com", "priority": "Low", "summary": "Write docs", "created": "2024-02-20 12:00"},
+		{"key": "PROJ-3", "status": "In Progress", "priority": "Highest", "summary": "Login page", "created": "2024-03-05 16:30", "labels": "frontend"},
+		{"key": "OPS-1", "status": "In Progress", "assignee": "alice@example.com", "priority": "Medium", "created": "2024-02-29 08:00"},
+	}
+	env := Env{
+		CurrentUser: "alice@example.com",
+		Now:         time.Date(2024, 3, 6, 12, 0, 0, 0, time.Local),
+	}
+
+	tests := []struct {
+		query     string
+		want      string // Keys of the matching records, in order
+		wantError string
+	}{
+		{query: ``, want: "PROJ-2 PROJ-10 PROJ-3 OPS-1"},
+		{query: `status != done`, want: "PROJ-2 PROJ-3 OPS-1"},
+		{query: `status != Done AND assignee in (alice@example.com, bob@example.com) ORDER BY priority`, want: "PROJ-2 OPS-1"},
+		{query: `assignee != bob@example.com`, want: "PROJ-2 OPS-1"},
+		{query: `assignee NOT IN (bob@example.com)`, want: "PROJ-2 OPS-1"},
+		{query: `assignee IS EMPTY OR assignee = bob@example.com`, want: "PROJ-10 PROJ-3"},
+		{query: `assignee IN (EMPTY, bob@example.com)`, want: "PROJ-10 PROJ-3"},
+		{query: `assignee = currentUser() AND NOT status = "In Progress"`, want: "PROJ-2"},
+		{query: `summary ~ LOGIN`, want: "PROJ-2 PROJ-3"},
+		{query: `summary !~ login`, want: "PROJ-10"},
+		{query: `labels = backend`, want: "PROJ-2"},
+		{query: `priority > Medium`, want: "PROJ-2 PROJ-3"},
+		{query: `created >= 2024-03-01`, want: "PROJ-2 PROJ-3"},
+		{query: `created < -7d`, want: "PROJ-10"},
+		{query: `created >= startOfDay()`, want: ""},
+		{query: `key > PROJ-3`, want: "PROJ-10"},
+		{query: `ORDER BY key`, want: "OPS-1 PROJ-2 PROJ-3 PROJ-10"},
+		{query: `ORDER BY priority`, want: "PROJ-3 PROJ-2 OPS-1 PROJ-10"},
+		{query: `ORDER BY priority ASC`, want: "PROJ-10 OPS-1 PROJ-2 PROJ-3"},
+		{query: `status = "In Progress" ORDER BY assignee DESC, created`, want: "OPS-1 PROJ-3"},
+		{query: `ORDER BY assignee`, want: "PROJ-2 OPS-1 PROJ-10 PROJ-3"},
+		{query: `reporter = x`, wantError: "unknown field: reporter"},
+		{query: `status = Done ORDER BY rank`, wantError: "unknown field: rank"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			stmt, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			matched, err := Filter(stmt, records, env)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("Filter() error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Filter() error = %v", err)
+			}
+
+			keys := make([]string, len(matched))
+			for i, r := range matched {
+				keys[i] = r["key"]
+			}
+			if got := strings.Join(keys, " "); got != tt.want {
+				t.Errorf("Filter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterCurrentUserUnknown(t *testing.T) {
+	stmt, err := Parse(`assignee = currentUser()`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	_, err = Filter(stmt, []fakeRecord{{"key": "PROJ-1"}}, Env{})
+	if err == nil || !strings.Contains(err.Error(), "currentUser() is unknown") {
+		t.Errorf("Filter() error = %v, want currentUser() is unknown", err)
+	}
+}
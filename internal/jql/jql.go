@@ -0,0 +1,376 @@
+// Package jql builds JIRA Query Language queries from typed clauses, quoting
+// field names and values so user input cannot change the structure of a query
+//
+//	query := jql.New().
+//		Where(jql.Eq("project", jql.String("PROJ"))).
+//		Where(jql.In("status", jql.Strings("To Do", "In Progress")...)).
+//		Where(jql.Eq("assignee", jql.CurrentUser())).
+//		OrderBy("updated", jql.Desc)
+//	query.String() // project = "PROJ" AND status IN ("To Do", "In Progress") AND assignee = currentUser() ORDER BY updated DESC
+package jql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date layouts JQL accepts for date values
+const (
+	DateLayout     = "2006-01-02"
+	DateTimeLayout = "2006-01-02 15:04"
+)
+
+// Sort directions for OrderBy
+const (
+	Asc  = "ASC"
+	Desc = "DESC"
+)
+
+var (
+	// plainFieldPattern matches field names that can be written without quotes,
+	// e.g. status, fixVersion, customfield_10010 or cf[10010]
+	plainFieldPattern = regexp.MustCompile(`^(?:[A-Za-z_][A-Za-z0-9_.]*|cf\[\d+\])$`)
+
+	// relativeDatePattern matches relative dates such as -7d, 2w or -4h 30m
+	relativeDatePattern = regexp.MustCompile(`^[-+]?\d+[wdhm](?:\s*[-+]?\d+[wdhm])*$`)
+
+	// textSpecialChars are the characters with a meaning in text searches (~)
+	textSpecialChars = `+-&|!(){}[]^~*?\:"`
+)
+
+// Value is a value on the right-hand side of a clause
+type Value interface {
+	jql() string
+}
+
+// literal is a value written into the query as-is
+type literal string
+
+func (l literal) jql() string { return string(l) }
+
+// String returns a quoted string value
+func String(s string) Value {
+	return literal(quote(s))
+}
+
+// Strings returns a quoted string value for each of ss
+func Strings(ss ...string) []Value {
+	values := make([]Value, len(ss))
+	for i, s := range ss {
+		values[i] = String(s)
+	}
+	return values
+}
+
+// Int returns a number value
+func Int(n int) Value {
+	return literal(strconv.Itoa(n))
+}
+
+// Date returns a date value, e.g. "2024-03-01"
+func Date(t time.Time) Value {
+	return literal(quote(t.Format(DateLayout)))
+}
+
+// DateTime returns a date and time value, e.g. "2024-03-01 14:30"
+func DateTime(t time.Time) Value {
+	return literal(quote(t.Format(DateTimeLayout)))
+}
+
+// RelativeDate returns a date relative to now, e.g. -7d or -2w
+func RelativeDate(offset string) (Value, error) {
+	offset = strings.TrimSpace(offset)
+	if !relativeDatePattern.MatchString(offset) {
+		return nil, fmt.Errorf("invalid relative date: %q (expected e.g. -7d, 2w, -4h)", offset)
+	}
+	return literal(quote(offset)), nil
+}
+
+// Func returns a function call value, e.g. Func("membersOf", String("developers"))
+func Func(name string, args ...Value) Value {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = arg.jql()
+	}
+	return literal(name + "(" + strings.Join(parts, ", ") + ")")
+}
+
+// CurrentUser returns the currentUser() function
+func CurrentUser() Value {
+	return Func("currentUser")
+}
+
+// Now returns the now() function
+func Now() Value {
+	return Func("now")
+}
+
+// OpenSprints returns the openSprints() function
+func OpenSprints() Value {
+	return Func("openSprints")
+}
+
+// quote encloses s in double quotes, escaping backslashes and quotes
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// Field quotes a field name when it cannot be written bare, e.g. "Story Points"
+func Field(name string) string {
+	if plainFieldPattern.MatchString(name) {
+		return name
+	}
+	return quote(name)
+}
+
+// Clause is a condition in a query
+type Clause struct {
+	text     string
+	compound bool // Joined by AND or OR, so parenthesized when nested
+}
+
+// String returns the clause as JQL
+func (c Clause) String() string {
+	return c.text
+}
+
+// IsZero reports whether the clause is empty
+func (c Clause) IsZero() bool {
+	return c.text == ""
+}
+
+func compare(field, op string, value Value) Clause {
+	return Clause{text: Field(field) + " " + op + " " + value.jql()}
+}
+
+// Eq matches issues whose field equals value
+func Eq(field string, value Value) Clause { return compare(field, "=", value) }
+
+// NotEq matches issues whose field does not equal value
+func NotEq(field string, value Value) Clause { return compare(field, "!=", value) }
+
+// Gt matches issues whose field is greater than value
+func Gt(field string, value Value) Clause { return compare(field, ">", value) }
+
+// Gte matches issues whose field is greater than or equal to value
+func Gte(field string, value Value) Clause { return compare(field, ">=", value) }
+
+// Lt matches issues whose field is less than value
+func Lt(field string, value Value) Clause { return compare(field, "<", value) }
+
+// Lte matches issues whose field is less than or equal to value
+func Lte(field string, value Value) Clause { return compare(field, "<=", value) }
+
+// In matches issues whose field is any of values; a single value is written as =
+func In(field string, values ...Value) Clause {
+	return list(field, "IN", "=", values)
+}
+
+// NotIn matches issues whose field is none of values; a single value is written as !=
+func NotIn(field string, values ...Value) Clause {
+	return list(field, "NOT IN", "!=", values)
+}
+
+func list(field, op, single string, values []Value) Clause {
+	switch len(values) {
+	case 0:
+		return Clause{}
+	case 1:
+		return compare(field, single, values[0])
+	}
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = value.jql()
+	}
+	return Clause{text: Field(field) + " " + op + " (" + strings.Join(parts, ", ") + ")"}
+}
+
+// Contains matches issues whose text field contains all the words of text
+// Characters with a meaning in text searches are escaped, so they match literally
+func Contains(field, text string) Clause {
+	var b strings.Builder
+	for _, r := range text {
+		if strings.ContainsRune(textSpecialChars, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return Clause{text: Field(field) + " ~ " + quote(b.String())}
+}
+
+// IsEmpty matches issues where field has no value
+func IsEmpty(field string) Clause {
+	return Clause{text: Field(field) + " IS EMPTY"}
+}
+
+// IsNotEmpty matches issues where field has a value
+func IsNotEmpty(field string) Clause {
+	return Clause{text: Field(field) + " IS NOT EMPTY"}
+}
+
+// Raw wraps a hand-written JQL condition, e.g. one given on the command line
+// It must not contain ORDER BY; use Query.WhereJQL for complete queries
+func Raw(condition string) Clause {
+	condition = strings.TrimSpace(condition)
+	return Clause{text: condition, compound: condition != ""}
+}
+
+// And matches issues matching every clause; empty clauses are skipped
+func And(clauses ...Clause) Clause {
+	return join(" AND ", clauses)
+}
+
+// Or matches issues matching any clause; empty clauses are skipped
+func Or(clauses ...Clause) Clause {
+	return join(" OR ", clauses)
+}
+
+// Not matches issues not matching clause
+func Not(clause Clause) Clause {
+	if clause.IsZero() {
+		return clause
+	}
+	return Clause{text: "NOT " + group(clause)}
+}
+
+func join(sep string, clauses []Clause) Clause {
+	var parts []string
+	for _, clause := range clauses {
+		if !clause.IsZero() {
+			parts = append(parts, group(clause))
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return Clause{}
+	case 1:
+		for _, clause := range clauses {
+			if !clause.IsZero() {
+				return clause
+			}
+		}
+	}
+	return Clause{text: strings.Join(parts, sep), compound: true}
+}
+
+// group parenthesizes a compound clause
+func group(clause Clause) string {
+	if clause.compound {
+		return "(" + clause.text + ")"
+	}
+	return clause.text
+}
+
+// Query is a JQL query: clauses joined by AND, then an optional ORDER BY
+type Query struct {
+	clauses []Clause
+	orderBy []string
+}
+
+// New returns an empty query
+func New() *Query {
+	return &Query{}
+}
+
+// Where adds clauses that every issue must match; empty clauses are skipped
+func (q *Query) Where(clauses ...Clause) *Query {
+	for _, clause := range clauses {
+		if !clause.IsZero() {
+			q.clauses = append(q.clauses, clause)
+		}
+	}
+	return q
+}
+
+// WhereJQL adds a hand-written query, e.g. one given on the command line
+// Its ORDER BY, if any, is kept after any added with OrderBy
+func (q *Query) WhereJQL(query string) *Query {
+	condition, orderBy := SplitOrderBy(query)
+	q.Where(Raw(condition))
+	if orderBy != "" {
+		q.orderBy = append(q.orderBy, orderBy)
+	}
+	return q
+}
+
+// OrderBy adds a sort key; direction is Asc, Desc or "" for the field's default
+func (q *Query) OrderBy(field, direction string) *Query {
+	key := Field(field)
+	if direction != "" {
+		key += " " + direction
+	}
+	q.orderBy = append(q.orderBy, key)
+	return q
+}
+
+// IsEmpty reports whether the query has no clauses
+func (q *Query) IsEmpty() bool {
+	return len(q.clauses) == 0
+}
+
+// String returns the query as JQL
+func (q *Query) String() string {
+	query := And(q.clauses...).String()
+	if len(q.orderBy) > 0 {
+		if query != "" {
+			query += " "
+		}
+		query += "ORDER BY " + strings.Join(q.orderBy, ", ")
+	}
+	return query
+}
+
+// SplitOrderBy splits a query into its condition and the sort keys of its ORDER BY
+// ORDER BY inside quoted strings is ignored
+func SplitOrderBy(query string) (condition, orderBy string) {
+	inQuote := rune(0)
+	for i := 0; i < len(query); i++ {
+		c := rune(query[i])
+		switch {
+		case inQuote != 0:
+			if c == '\\' {
+				i++
+			} else if c == inQuote {
+				inQuote = 0
+			}
+		case c == '"' || c == '\'':
+			inQuote = c
+		case hasPrefixFold(query[i:], "ORDER") && (i == 0 || isSpace(query[i-1])):
+			rest := strings.TrimLeft(query[i+len("ORDER"):], " \t\r\n")
+			if len(rest) < len(query[i+len("ORDER"):]) && hasPrefixFold(rest, "BY") {
+				return strings.TrimSpace(query[:i]), strings.TrimSpace(rest[len("BY"):])
+			}
+		}
+	}
+	return strings.TrimSpace(query), ""
+}
+
+// hasPrefixFold reports whether s begins with prefix, ignoring case
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
@@ -0,0 +1,146 @@
+package jql
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuery(t *testing.T) {
+	updated := time.Date(2024, 3, 1, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query *Query
+		want  string
+	}{
+		{
+			name:  "empty",
+			query: New(),
+			want:  "",
+		},
+		{
+			name: "clauses joined by AND",
+			query: New().
+				Where(Eq("project", String("PROJ"))).
+				Where(In("status", Strings("To Do", "In Progress")...)).
+				Where(Eq("assignee", CurrentUser())),
+			want: `project = "PROJ" AND status IN ("To Do", "In Progress") AND assignee = currentUser()`,
+		},
+		{
+			name:  "single value list",
+			query: New().Where(In("labels", String("backend")), NotIn("type", String("Epic"))),
+			want:  `labels = "backend" AND type != "Epic"`,
+		},
+		{
+			name:  "empty list skipped",
+			query: New().Where(In("labels"), IsEmpty("assignee")),
+			want:  `assignee IS EMPTY`,
+		},
+		{
+			name:  "quotes and backslashes escaped",
+			query: New().Where(Eq("summary", String(`say "hi" \ bye`))),
+			want:  `summary = "say \"hi\" \\ bye"`,
+		},
+		{
+			name:  "field names quoted",
+			query: New().Where(Gt("Story Points", Int(3)), Eq("cf[10010]", String("x"))),
+			want:  `"Story Points" > 3 AND cf[10010] = "x"`,
+		},
+		{
+			name:  "text search escaped",
+			query: New().Where(Contains("text", `fix "login" [urgent]`)),
+			want:  `text ~ "fix \\\"login\\\" \\[urgent\\]"`,
+		},
+		{
+			name:  "dates",
+			query: New().Where(Gte("updated", Date(updated)), Lt("created", DateTime(updated))),
+			want:  `updated >= "2024-03-01" AND created < "2024-03-01 14:30"`,
+		},
+		{
+			name: "OR grouped inside AND",
+			query: New().
+				Where(Or(Eq("priority", String("High")), IsEmpty("duedate"))).
+				Where(Not(Or(Eq("status", String("Done")), Eq("status", String("Closed"))))),
+			want: `(priority = "High" OR duedate IS EMPTY) AND NOT (status = "Done" OR status = "Closed")`,
+		},
+		{
+			name:  "function arguments",
+			query: New().Where(In("sprint", OpenSprints()), In("assignee", Func("membersOf", String("dev team")))),
+			want:  `sprint = openSprints() AND assignee = membersOf("dev team")`,
+		},
+		{
+			name:  "order by",
+			query: New().Where(Eq("project", String("PROJ"))).OrderBy("priority", Desc).OrderBy("key", ""),
+			want:  `project = "PROJ" ORDER BY priority DESC, key`,
+		},
+		{
+			name:  "hand-written query combined",
+			query: New().WhereJQL(`status = "Done" OR resolution IS EMPTY order by created DESC`).Where(Eq("project", String("PROJ"))),
+			want:  `(status = "Done" OR resolution IS EMPTY) AND project = "PROJ" ORDER BY created DESC`,
+		},
+		{
+			name:  "hand-written query alone",
+			query: New().WhereJQL(`ORDER BY rank`),
+			want:  `ORDER BY rank`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.String(); got != tt.want {
+				t.Errorf("String() = %s\nwant       %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitOrderBy(t *testing.T) {
+	tests := []struct {
+		query         string
+		wantCondition string
+		wantOrderBy   string
+	}{
+		{`project = PROJ`, `project = PROJ`, ``},
+		{`project = PROJ ORDER BY key ASC`, `project = PROJ`, `key ASC`},
+		{`summary ~ "order by" order  by  rank`, `summary ~ "order by"`, `rank`},
+		{`summary ~ 'ORDER BY x'`, `summary ~ 'ORDER BY x'`, ``},
+		{`reorder = 1`, `reorder = 1`, ``},
+		{`summary ~ "ſſſſſſſſſſſſſſ" ORDER BY key`, `summary ~ "ſſſſſſſſſſſſſſ"`, `key`},
+		{`summary ~ ıııııı order by key`, `summary ~ ıııııı`, `key`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			condition, orderBy := SplitOrderBy(tt.query)
+			if condition != tt.wantCondition || orderBy != tt.wantOrderBy {
+				t.Errorf("SplitOrderBy() = %q, %q, want %q, %q", condition, orderBy, tt.wantCondition, tt.wantOrderBy)
+			}
+		})
+	}
+}
+
+func TestRelativeDate(t *testing.T) {
+	tests := []struct {
+		offset  string
+		want    string
+		wantErr bool
+	}{
+		{"-7d", `"-7d"`, false},
+		{"2w", `"2w"`, false},
+		{"-4h 30m", `"-4h 30m"`, false},
+		{"7 days", "", true},
+		{`-1d" OR project = X`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.offset, func(t *testing.T) {
+			value, err := RelativeDate(tt.offset)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RelativeDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && value.jql() != tt.want {
+				t.Errorf("RelativeDate() = %s, want %s", value.jql(), tt.want)
+			}
+		})
+	}
+}
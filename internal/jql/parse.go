@@ -0,0 +1,497 @@
+package jql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Operators of a parsed condition
+const (
+	OpEq       = "="
+	OpNotEq    = "!="
+	OpContains = "~"
+	OpNotMatch = "!~"
+	OpLt       = "<"
+	OpLte      = "<="
+	OpGt       = ">"
+	OpGte      = ">="
+	OpIn       = "IN"
+	OpNotIn    = "NOT IN"
+	OpIs       = "IS"
+	OpIsNot    = "IS NOT"
+)
+
+// Keywords joining and negating conditions
+const (
+	logicalAnd = "AND"
+	logicalOr  = "OR"
+	keywordNot = "NOT"
+)
+
+// functions are the functions a parsed query may call
+var functions = map[string]string{
+	"currentuser": "currentUser",
+	"now":         "now",
+	"startofday":  "startOfDay",
+	"endofday":    "endOfDay",
+}
+
+// reserved are the words that cannot be used as bare field names or values
+var reserved = map[string]bool{
+	"AND": true, "OR": true, "NOT": true, "IN": true, "IS": true, "ORDER": true,
+}
+
+// Statement is a parsed query: an optional condition and optional sort keys
+type Statement struct {
+	Where   Expr // nil matches everything
+	OrderBy []SortKey
+}
+
+// String returns the statement as JQL
+func (s *Statement) String() string {
+	var parts []string
+	if s.Where != nil {
+		parts = append(parts, s.Where.String())
+	}
+	if len(s.OrderBy) > 0 {
+		keys := make([]string, len(s.OrderBy))
+		for i, key := range s.OrderBy {
+			keys[i] = Field(key.Field)
+			if key.Direction != "" {
+				keys[i] += " " + key.Direction
+			}
+		}
+		parts = append(parts, "ORDER BY "+strings.Join(keys, ", "))
+	}
+	return strings.Join(parts, " ")
+}
+
+// SortKey is a field in an ORDER BY; Direction is Asc, Desc or "" for the field's default
+type SortKey struct {
+	Field     string
+	Direction string
+}
+
+// Expr is a node of a parsed condition
+type Expr interface {
+	String() string
+}
+
+// BinaryExpr joins two conditions with AND or OR
+type BinaryExpr struct {
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+// String returns the expression as JQL, parenthesizing nested ORs inside ANDs
+func (e *BinaryExpr) String() string {
+	return e.side(e.Left) + " " + e.Op + " " + e.side(e.Right)
+}
+
+func (e *BinaryExpr) side(x Expr) string {
+	if inner, ok := x.(*BinaryExpr); ok && inner.Op != e.Op {
+		return "(" + inner.String() + ")"
+	}
+	return x.String()
+}
+
+// NotExpr negates a condition
+type NotExpr struct {
+	X Expr
+}
+
+// String returns the expression as JQL
+func (e *NotExpr) String() string {
+	if _, ok := e.X.(*BinaryExpr); ok {
+		return "NOT (" + e.X.String() + ")"
+	}
+	return "NOT " + e.X.String()
+}
+
+// Condition compares a field with its operands, e.g. status IN ("To Do", Done)
+type Condition struct {
+	Field    string
+	Op       string // One of the Op constants
+	Operands []Operand
+}
+
+// String returns the condition as JQL
+func (c *Condition) String() string {
+	text := Field(c.Field) + " " + c.Op + " "
+	if c.Op == OpIn || c.Op == OpNotIn {
+		parts := make([]string, len(c.Operands))
+		for i, operand := range c.Operands {
+			parts[i] = operand.String()
+		}
+		return text + "(" + strings.Join(parts, ", ") + ")"
+	}
+	return text + c.Operands[0].String()
+}
+
+// Operand kinds
+const (
+	OperandValue = iota // A string, number or date
+	OperandEmpty        // EMPTY or NULL
+	OperandFunc         // A function call such as currentUser()
+)
+
+// Operand is a value on the right-hand side of a condition
+type Operand struct {
+	Kind int
+	Text string // The value, or the function name
+}
+
+// String returns the operand as JQL
+func (o Operand) String() string {
+	switch o.Kind {
+	case OperandEmpty:
+		return "EMPTY"
+	case OperandFunc:
+		return o.Text + "()"
+	}
+	return quote(o.Text)
+}
+
+// Parse parses a query in a subset of JQL: conditions using =, !=, ~, !~, <, <=,
+// >, >=, IN, NOT IN and IS [NOT] EMPTY, joined by AND, OR and NOT with
+// parentheses, followed by an optional ORDER BY
+func Parse(query string) (*Statement, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+
+	stmt := &Statement{}
+	if !p.peekKeyword("ORDER") && p.peek().kind != tokenEOF {
+		if stmt.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peekKeyword("ORDER") {
+		if stmt.OrderBy, err = p.parseOrderBy(); err != nil {
+			return nil, err
+		}
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok, "unexpected %s", tok)
+	}
+	return stmt, nil
+}
+
+// Token kinds
+const (
+	tokenEOF = iota
+	tokenWord
+	tokenString
+	tokenOp
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind int
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// lex splits a query into tokens
+func lex(query string) ([]token, error) {
+	var tokens []token
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: i})
+			i++
+		case r == '"' || r == '\'':
+			text, end, err := lexString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i})
+			i = end
+		case strings.ContainsRune("=!<>~", r):
+			op := string(r)
+			if i+1 < len(runes) && (runes[i+1] == '=' && r != '=' && r != '~' || runes[i+1] == '~' && r == '!') {
+				op += string(runes[i+1])
+			}
+			tokens = append(tokens, token{kind: tokenOp, text: op, pos: i})
+			i += len(op)
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`(),"'=!<>~`, runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[start:i]), pos: start})
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+// lexString reads the quoted string starting at runes[start], returning its
+// unescaped text and the index after the closing quote
+func lexString(runes []rune, start int) (string, int, error) {
+	var b strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == runes[start]:
+			return b.String(), i + 1, nil
+		case r == '\\' && i+1 < len(runes):
+			i++
+			switch runes[i] {
+			case 'n':
+				b.WriteRune('\n')
+			case 'r':
+				b.WriteRune('\r')
+			case 't':
+				b.WriteRune('\t')
+			default:
+				b.WriteRune(runes[i])
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string at position %d", start+1)
+}
+
+// parser is a recursive-descent parser over the tokens of a query
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// peekKeyword reports whether the next token is the bare word keyword, in any case
+func (p *parser) peekKeyword(keyword string) bool {
+	tok := p.peek()
+	return tok.kind == tokenWord && strings.EqualFold(tok.text, keyword)
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), tok.pos+1)
+}
+
+// parseOr parses conditions joined by OR
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword(logicalOr) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryExpr{Op: logicalOr, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses conditions joined by AND, which binds tighter than OR
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword(logicalAnd) {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryExpr{Op: logicalAnd, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseNot parses a condition, a parenthesized expression, or either negated by NOT or !
+func (p *parser) parseNot() (Expr, error) {
+	if tok := p.peek(); p.peekKeyword(keywordNot) || tok.kind == tokenOp && tok.text == "!" {
+		p.next()
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{X: x}, nil
+	}
+
+	if p.peek().kind == tokenLParen {
+		p.next()
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); tok.kind != tokenRParen {
+			return nil, p.errorf(tok, "expected ) but found %s", tok)
+		}
+		return x, nil
+	}
+
+	return p.parseCondition()
+}
+
+// parseCondition parses a field, an operator and its operands
+func (p *parser) parseCondition() (Expr, error) {
+	field := p.next()
+	if field.kind != tokenWord && field.kind != tokenString || field.kind == tokenWord && reserved[strings.ToUpper(field.text)] {
+		return nil, p.errorf(field, "expected a field but found %s", field)
+	}
+	c := &Condition{Field: field.text}
+
+	tok := p.next()
+	switch {
+	case tok.kind == tokenOp && tok.text != "!":
+		c.Op = tok.text
+	case tok.kind == tokenWord && strings.EqualFold(tok.text, OpIn):
+		c.Op = OpIn
+	case tok.kind == tokenWord && strings.EqualFold(tok.text, keywordNot) && p.peekKeyword(OpIn):
+		p.next()
+		c.Op = OpNotIn
+	case tok.kind == tokenWord && strings.EqualFold(tok.text, OpIs):
+		c.Op = OpIs
+		if p.peekKeyword(keywordNot) {
+			p.next()
+			c.Op = OpIsNot
+		}
+	default:
+		return nil, p.errorf(tok, "expected an operator after %s but found %s", field, tok)
+	}
+
+	switch c.Op {
+	case OpIn, OpNotIn:
+		operands, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		c.Operands = operands
+	default:
+		at := p.peek()
+		operand, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		isEmpty := operand.Kind == OperandEmpty
+		switch c.Op {
+		case OpIs, OpIsNot:
+			if !isEmpty {
+				return nil, p.errorf(at, "%s must be followed by EMPTY", c.Op)
+			}
+		case OpEq, OpNotEq:
+		default:
+			if isEmpty {
+				return nil, p.errorf(at, "%s cannot be compared with EMPTY", c.Op)
+			}
+		}
+		c.Operands = []Operand{operand}
+	}
+	return c, nil
+}
+
+// parseList parses a parenthesized, comma-separated list of operands
+func (p *parser) parseList() ([]Operand, error) {
+	if tok := p.next(); tok.kind != tokenLParen {
+		return nil, p.errorf(tok, "expected ( but found %s", tok)
+	}
+	var operands []Operand
+	for {
+		operand, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+
+		switch tok := p.next(); tok.kind {
+		case tokenComma:
+		case tokenRParen:
+			return operands, nil
+		default:
+			return nil, p.errorf(tok, "expected , or ) but found %s", tok)
+		}
+	}
+}
+
+// parseOperand parses a value, EMPTY or NULL, or a function call
+func (p *parser) parseOperand() (Operand, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == tokenString:
+		return Operand{Kind: OperandValue, Text: tok.text}, nil
+	case tok.kind != tokenWord || reserved[strings.ToUpper(tok.text)]:
+		return Operand{}, p.errorf(tok, "expected a value but found %s", tok)
+	case strings.EqualFold(tok.text, "EMPTY") || strings.EqualFold(tok.text, "NULL"):
+		return Operand{Kind: OperandEmpty}, nil
+	case p.peek().kind == tokenLParen:
+		name, ok := functions[strings.ToLower(tok.text)]
+		if !ok {
+			return Operand{}, p.errorf(tok, "unsupported function %s()", tok.text)
+		}
+		p.next()
+		if close := p.next(); close.kind != tokenRParen {
+			return Operand{}, p.errorf(close, "%s() takes no arguments", name)
+		}
+		return Operand{Kind: OperandFunc, Text: name}, nil
+	}
+	return Operand{Kind: OperandValue, Text: tok.text}, nil
+}
+
+// parseOrderBy parses ORDER BY and its comma-separated sort keys
+func (p *parser) parseOrderBy() ([]SortKey, error) {
+	p.next()
+	if tok := p.next(); tok.kind != tokenWord || !strings.EqualFold(tok.text, "BY") {
+		return nil, p.errorf(tok, "expected BY after ORDER but found %s", tok)
+	}
+
+	var keys []SortKey
+	for {
+		field := p.next()
+		if field.kind != tokenWord && field.kind != tokenString {
+			return nil, p.errorf(field, "expected a field to sort by but found %s", field)
+		}
+		key := SortKey{Field: field.text}
+		if p.peekKeyword(Asc) || p.peekKeyword(Desc) {
+			key.Direction = strings.ToUpper(p.next().text)
+		}
+		keys = append(keys, key)
+
+		if p.peek().kind != tokenComma {
+			return keys, nil
+		}
+		p.next()
+	}
+}
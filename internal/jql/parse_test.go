@@ -0,0 +1,80 @@
+package jql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		query     string
+		want      string
+		wantError string
+	}{
+		{
+			query: `status != Done AND assignee in (a, b) ORDER BY priority`,
+			want:  `status != "Done" AND assignee IN ("a", "b") ORDER BY priority`,
+		},
+		{
+			query: `project = PROJ and (status = "In Progress" or priority >= High) order by created desc, key`,
+			want:  `project = "PROJ" AND (status = "In Progress" OR priority >= "High") ORDER BY created DESC, key`,
+		},
+		{
+			query: `a = 1 OR b = 2 AND c = 3`,
+			want:  `a = "1" OR (b = "2" AND c = "3")`,
+		},
+		{
+			query: `NOT (status = Done OR status = Closed) AND !parent IS EMPTY`,
+			want:  `NOT (status = "Done" OR status = "Closed") AND NOT parent IS EMPTY`,
+		},
+		{
+			query: `assignee is not null and due < -7d and summary ~ 'it\'s "login"'`,
+			want:  `assignee IS NOT EMPTY AND due < "-7d" AND summary ~ "it's \"login\""`,
+		},
+		{
+			query: `status NOT IN (Done, EMPTY) AND assignee = currentUser() AND summary !~ wip`,
+			want:  `status NOT IN ("Done", EMPTY) AND assignee = currentUser() AND summary !~ "wip"`,
+		},
+		{
+			query: `"Story Points" > 3`,
+			want:  `"Story Points" > "3"`,
+		},
+		{
+			query: `ORDER BY key ASC`,
+			want:  `ORDER BY key ASC`,
+		},
+		{
+			query: ``,
+			want:  ``,
+		},
+		{query: `status = `, wantError: "expected a value but found end of query at position 10"},
+		{query: `status Done`, wantError: `expected an operator after "status" but found "Done"`},
+		{query: `status IN Done`, wantError: "expected ( but found"},
+		{query: `(status = Done`, wantError: "expected ) but found end of query"},
+		{query: `status = Done project = PROJ`, wantError: `unexpected "project" at position 15`},
+		{query: `summary ~ "open`, wantError: "unterminated string at position 11"},
+		{query: `assignee IS Bob`, wantError: "IS must be followed by EMPTY"},
+		{query: `created > EMPTY`, wantError: "> cannot be compared with EMPTY"},
+		{query: `assignee = membersOf()`, wantError: "unsupported function membersOf()"},
+		{query: `status = Done ORDER priority`, wantError: "expected BY after ORDER"},
+		{query: `AND = x`, wantError: "expected a field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			stmt, err := Parse(tt.query)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("Parse() error = %v, want %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := stmt.String(); got != tt.want {
+				t.Errorf("Parse() = %s\nwant      %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,13 +16,176 @@ type HTMLReporter struct{}
 func (r *HTMLReporter) Generate(issues []jira.Issue) (string, error) {
 	var sb strings.Builder
 
+	writeHTMLHeader(&sb, "JIRA Tickets Report")
+
+	// Header
+	sb.WriteString("<h1>📋 JIRA Tickets Report</h1>\n")
+	sb.WriteString(fmt.Sprintf("<div class=\"meta\">Generated: %s</div>\n", time.Now().Format("2006-01-02 15:04:05")))
+
+	// Summary
+	sb.WriteString("<h2>Summary</h2>\n")
+	sb.WriteString("<div class=\"summary-box\">\n")
+	sb.WriteString(fmt.Sprintf("<p><strong>Total Tickets:</strong> %d</p>\n", len(issues)))
+
+	// Count by type
+	typeCount := make(map[string]int)
+	priorityCount := make(map[string]int)
+	for _, issue := range issues {
+		typeCount[issue.Fields.IssueType.Name]++
+		if issue.Fields.Priority != nil {
+			priorityCount[issue.Fields.Priority.Name]++
+		}
+	}
+
+	if len(typeCount) > 0 {
+		sb.WriteString("<p><strong>By Type:</strong> ")
+		for typ, count := range typeCount {
+			sb.WriteString(fmt.Sprintf("%s: %d, ", typ, count))
+		}
+		sb.WriteString("</p>\n")
+	}
+
+	if len(priorityCount) > 0 {
+		sb.WriteString("<p><strong>By Priority:</strong> ")
+		for priority, count := range priorityCount {
+			sb.WriteString(fmt.Sprintf("%s: %d, ", priority, count))
+		}
+		sb.WriteString("</p>\n")
+	}
+
+	sb.WriteString("</div>\n")
+
+	// Table
+	sb.WriteString("<h2>Tickets</h2>\n")
+	sb.WriteString("<table>\n")
+	sb.WriteString("<thead><tr><th>Key</th><th>Type</th><th>Summary</th><th>Assignee</th><th>Priority</th></tr></thead>\n")
+	sb.WriteString("<tbody>\n")
+
+	for _, issue := range issues {
+		assignee := "-"
+		if issue.Fields.Assignee != nil && issue.Fields.Assignee.Name != "" {
+			assignee = html.EscapeString(issue.Fields.Assignee.Name)
+		}
+
+		priority := "-"
+		priorityClass := ""
+		if issue.Fields.Priority != nil {
+			priority = html.EscapeString(issue.Fields.Priority.Name)
+			priorityClass = fmt.Sprintf(" class=\"priority-%s\"", strings.ToLower(priority))
+		}
+
+		typeClass := fmt.Sprintf("type-%s", strings.ToLower(strings.ReplaceAll(issue.Fields.IssueType.Name, " ", "-")))
+
+		sb.WriteString(fmt.Sprintf(
+			"<tr><td><span class=\"key\">%s</span></td><td><span class=\"type-badge %s\">%s</span></td><td>%s</td><td>%s</td><td%s>%s</td></tr>\n",
+			issue.Key,
+			typeClass,
+			html.EscapeString(issue.Fields.IssueType.Name),
+			html.EscapeString(issue.Fields.Summary),
+			assignee,
+			priorityClass,
+			priority))
+	}
+
+	sb.WriteString("</tbody>\n")
+	sb.WriteString("</table>\n")
+
+	// Details
+	sb.WriteString("<h2>Details</h2>\n")
+	for _, issue := range issues {
+		sb.WriteString("<div class=\"detail-section\">\n")
+		sb.WriteString(fmt.Sprintf("<h3>%s</h3>\n", html.EscapeString(issue.Key)))
+
+		sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Summary:</strong> %s</div>\n", html.EscapeString(issue.Fields.Summary)))
+
+		if issue.Fields.Description != "" {
+			sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Description:</strong> %s</div>\n", html.EscapeString(issue.Fields.Description)))
+		}
+
+		sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Type:</strong> %s</div>\n", html.EscapeString(issue.Fields.IssueType.Name)))
+
+		if issue.Fields.Priority != nil {
+			sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Priority:</strong> <span class=\"priority-%s\">%s</span></div>\n",
+				strings.ToLower(issue.Fields.Priority.Name),
+				html.EscapeString(issue.Fields.Priority.Name)))
+		}
+
+		if issue.Fields.Assignee != nil && (issue.Fields.Assignee.Name != "" || issue.Fields.Assignee.EmailAddress != "") {
+			assignee := issue.Fields.Assignee.Name
+			if assignee == "" {
+				assignee = issue.Fields.Assignee.EmailAddress
+			}
+			sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Assignee:</strong> %s</div>\n", html.EscapeString(assignee)))
+		}
+
+		if len(issue.Fields.Labels) > 0 {
+			sb.WriteString("<div class=\"detail-row\"><strong>Labels:</strong> ")
+			for _, label := range issue.Fields.Labels {
+				sb.WriteString(fmt.Sprintf("<span class=\"label\">%s</span>", html.EscapeString(label)))
+			}
+			sb.WriteString("</div>\n")
+		}
+
+		if issue.Fields.Comment != nil && len(issue.Fields.Comment.Comments) > 0 {
+			sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Comments:</strong> %d</div>\n", len(issue.Fields.Comment.Comments)))
+			for _, comment := range issue.Fields.Comment.Comments {
+				author := "Unknown"
+				if comment.Author != nil {
+					if comment.Author.DisplayName != "" {
+						author = comment.Author.DisplayName
+					} else if comment.Author.Name != "" {
+						author = comment.Author.Name
+					} else if comment.Author.EmailAddress != "" {
+						author = comment.Author.EmailAddress
+					}
+				}
+				sb.WriteString("<div class=\"comment\">\n")
+				sb.WriteString(fmt.Sprintf("<div class=\"comment-meta\">%s &middot; %s", html.EscapeString(author), html.EscapeString(comment.Created)))
+				if comment.Visibility != nil {
+					sb.WriteString(fmt.Sprintf(" &middot; 🔒 %s", html.EscapeString(comment.Visibility.Value)))
+				}
+				sb.WriteString("</div>\n")
+				sb.WriteString(fmt.Sprintf("<div class=\"comment-body\">%s</div>\n", html.EscapeString(comment.Body)))
+				sb.WriteString("</div>\n")
+			}
+		}
+
+		sb.WriteString("</div>\n")
+	}
+
+	writeHTMLFooter(&sb)
+
+	return sb.String(), nil
+}
+
+// writeHTMLHeader writes the document head and opens the report container
+// shared by all HTML reports
+func writeHTMLHeader(sb *strings.Builder, title string) {
 	sb.WriteString(`<!DOCTYPE html>
 <html lang="en">
 <head>
     <meta charset="UTF-8">
     <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>JIRA Tickets Report</title>
-    <style>
+`)
+	sb.WriteString("    <title>" + html.EscapeString(title) + "</title>\n")
+	sb.WriteString(htmlStyles)
+	sb.WriteString(`</head>
+<body>
+    <div class="container">
+`)
+}
+
+// writeHTMLFooter closes the report container and document
+func writeHTMLFooter(sb *strings.Builder) {
+	sb.WriteString(`
+    </div>
+</body>
+</html>
+`)
+}
+
+// htmlStyles is the stylesheet shared by all HTML reports
+const htmlStyles = `    <style>
         * {
             margin: 0;
             padding: 0;
@@ -162,128 +325,26 @@ func (r *HTMLReporter) Generate(issues []jira.Issue) (string, error) {
             margin-right: 5px;
             margin-bottom: 5px;
         }
-    </style>
-</head>
-<body>
-    <div class="container">
-`)
-
-	// Header
-	sb.WriteString("<h1>📋 JIRA Tickets Report</h1>\n")
-	sb.WriteString(fmt.Sprintf("<div class=\"meta\">Generated: %s</div>\n", time.Now().Format("2006-01-02 15:04:05")))
-
-	// Summary
-	sb.WriteString("<h2>Summary</h2>\n")
-	sb.WriteString("<div class=\"summary-box\">\n")
-	sb.WriteString(fmt.Sprintf("<p><strong>Total Tickets:</strong> %d</p>\n", len(issues)))
-
-	// Count by type
-	typeCount := make(map[string]int)
-	priorityCount := make(map[string]int)
-	for _, issue := range issues {
-		typeCount[issue.Fields.IssueType.Name]++
-		if issue.Fields.Priority != nil {
-			priorityCount[issue.Fields.Priority.Name]++
-		}
-	}
-
-	if len(typeCount) > 0 {
-		sb.WriteString("<p><strong>By Type:</strong> ")
-		for typ, count := range typeCount {
-			sb.WriteString(fmt.Sprintf("%s: %d, ", typ, count))
-		}
-		sb.WriteString("</p>\n")
-	}
-
-	if len(priorityCount) > 0 {
-		sb.WriteString("<p><strong>By Priority:</strong> ")
-		for priority, count := range priorityCount {
-			sb.WriteString(fmt.Sprintf("%s: %d, ", priority, count))
-		}
-		sb.WriteString("</p>\n")
-	}
-
-	sb.WriteString("</div>\n")
-
-	// Table
-	sb.WriteString("<h2>Tickets</h2>\n")
-	sb.WriteString("<table>\n")
-	sb.WriteString("<thead><tr><th>Key</th><th>Type</th><th>Summary</th><th>Assignee</th><th>Priority</th></tr></thead>\n")
-	sb.WriteString("<tbody>\n")
-
-	for _, issue := range issues {
-		assignee := "-"
-		if issue.Fields.Assignee != nil && issue.Fields.Assignee.Name != "" {
-			assignee = html.EscapeString(issue.Fields.Assignee.Name)
-		}
-
-		priority := "-"
-		priorityClass := ""
-		if issue.Fields.Priority != nil {
-			priority = html.EscapeString(issue.Fields.Priority.Name)
-			priorityClass = fmt.Sprintf(" class=\"priority-%s\"", strings.ToLower(priority))
-		}
-
-		typeClass := fmt.Sprintf("type-%s", strings.ToLower(strings.ReplaceAll(issue.Fields.IssueType.Name, " ", "-")))
-
-		sb.WriteString(fmt.Sprintf(
-			"<tr><td><span class=\"key\">%s</span></td><td><span class=\"type-badge %s\">%s</span></td><td>%s</td><td>%s</td><td%s>%s</td></tr>\n",
-			issue.Key,
-			typeClass,
-			html.EscapeString(issue.Fields.IssueType.Name),
-			html.EscapeString(issue.Fields.Summary),
-			assignee,
-			priorityClass,
-			priority))
-	}
 
-	sb.WriteString("</tbody>\n")
-	sb.WriteString("</table>\n")
-
-	// Details
-	sb.WriteString("<h2>Details</h2>\n")
-	for _, issue := range issues {
-		sb.WriteString("<div class=\"detail-section\">\n")
-		sb.WriteString(fmt.Sprintf("<h3>%s</h3>\n", html.EscapeString(issue.Key)))
-
-		sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Summary:</strong> %s</div>\n", html.EscapeString(issue.Fields.Summary)))
-
-		if issue.Fields.Description != "" {
-			sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Description:</strong> %s</div>\n", html.EscapeString(issue.Fields.Description)))
-		}
-
-		sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Type:</strong> %s</div>\n", html.EscapeString(issue.Fields.IssueType.Name)))
-
-		if issue.Fields.Priority != nil {
-			sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Priority:</strong> <span class=\"priority-%s\">%s</span></div>\n",
-				strings.ToLower(issue.Fields.Priority.Name),
-				html.EscapeString(issue.Fields.Priority.Name)))
-		}
-
-		if issue.Fields.Assignee != nil && (issue.Fields.Assignee.Name != "" || issue.Fields.Assignee.EmailAddress != "") {
-			assignee := issue.Fields.Assignee.Name
-			if assignee == "" {
-				assignee = issue.Fields.Assignee.EmailAddress
-			}
-			sb.WriteString(fmt.Sprintf("<div class=\"detail-row\"><strong>Assignee:</strong> %s</div>\n", html.EscapeString(assignee)))
-		}
-
-		if len(issue.Fields.Labels) > 0 {
-			sb.WriteString("<div class=\"detail-row\"><strong>Labels:</strong> ")
-			for _, label := range issue.Fields.Labels {
-				sb.WriteString(fmt.Sprintf("<span class=\"label\">%s</span>", html.EscapeString(label)))
-			}
-			sb.WriteString("</div>\n")
-		}
+        .comment {
+            border-left: 3px solid #ddd;
+            padding: 8px 12px;
+            margin: 8px 0 8px 20px;
+        }
 
-		sb.WriteString("</div>\n")
-	}
+        .comment-meta {
+            color: #666;
+            font-size: 12px;
+            margin-bottom: 4px;
+        }
 
-	sb.WriteString(`
-    </div>
-</body>
-</html>
-`)
+        .comment-body {
+            white-space: pre-wrap;
+        }
 
-	return sb.String(), nil
-}
+        .total-row td {
+            font-weight: 600;
+            background-color: #f5f7fa;
+        }
+    </style>
+`
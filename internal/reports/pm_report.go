@@ -102,12 +102,17 @@ func (r *PMReport) GenerateProjectHierarchy(records []jira.TicketRecord) string
 			output.WriteString(fmt.Sprintf("   Status: %s | Priority: %s | Owner: %s\n",
 				ticket.Status, ticket.Priority, ticket.Assignee))
 
-			// Find child tickets (those blocking this one or blocked by this)
+			// Find child tickets: those parented to the epic, or (for tickets
+			// created before parents were supported) blocked by it
 			children := []jira.TicketRecord{}
 			for _, candidate := range records {
 				if candidate.Key == ticket.Key {
 					continue
 				}
+				if candidate.Parent == ticket.Key {
+					children = append(children, candidate)
+					continue
+				}
 				// Check if related through blocking
 				for _, blocker := range candidate.BlockedBy {
 					if blocker == ticket.Key {
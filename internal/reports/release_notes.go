@@ -0,0 +1,181 @@
+package reports
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+)
+
+// releaseTypeOrder lists issue types in the order they appear in release notes
+// Other types follow in alphabetical order
+var releaseTypeOrder = []string{"Epic", "New Feature", "Story", "Improvement", "Task", "Bug", "Sub-task"}
+
+// ReleaseNotesGroup is the issues of one type in a release
+type ReleaseNotesGroup struct {
+	Type   string
+	Issues []jira.Issue
+}
+
+// ReleaseNotes lists the issues fixed in a version, grouped by issue type
+type ReleaseNotes struct {
+	Version jira.Version
+	BaseURL string // JIRA base URL, used to link each issue
+	Groups  []ReleaseNotesGroup
+}
+
+// NewReleaseNotes groups issues by type for a version's release notes
+func NewReleaseNotes(version jira.Version, baseURL string, issues []jira.Issue) *ReleaseNotes {
+	byType := make(map[string][]jira.Issue)
+	for _, issue := range issues {
+		typ := issue.Fields.IssueType.Name
+		if typ == "" {
+			typ = "Other"
+		}
+		byType[typ] = append(byType[typ], issue)
+	}
+
+	rank := make(map[string]int, len(releaseTypeOrder))
+	for i, typ := range releaseTypeOrder {
+		rank[typ] = i + 1
+	}
+
+	notes := &ReleaseNotes{Version: version, BaseURL: strings.TrimRight(baseURL, "/")}
+	for typ, group := range byType {
+		notes.Groups = append(notes.Groups, ReleaseNotesGroup{Type: typ, Issues: group})
+	}
+	sort.Slice(notes.Groups, func(i, j int) bool {
+		a, b := notes.Groups[i].Type, notes.Groups[j].Type
+		ra, rb := rank[a], rank[b]
+		if ra == 0 {
+			ra = len(releaseTypeOrder) + 1
+		}
+		if rb == 0 {
+			rb = len(releaseTypeOrder) + 1
+		}
+		if ra != rb {
+			return ra < rb
+		}
+		return a < b
+	})
+
+	return notes
+}
+
+// IssueURL returns the browse link for an issue
+func (n *ReleaseNotes) IssueURL(key string) string {
+	return n.BaseURL + "/browse/" + key
+}
+
+// Total returns the number of issues in the release
+func (n *ReleaseNotes) Total() int {
+	total := 0
+	for _, group := range n.Groups {
+		total += len(group.Issues)
+	}
+	return total
+}
+
+// releaseStatus describes whether and when the version was released
+func (n *ReleaseNotes) releaseStatus() string {
+	switch {
+	case n.Version.Released && n.Version.ReleaseDate != "":
+		return "Released " + n.Version.ReleaseDate
+	case n.Version.Released:
+		return "Released"
+	case n.Version.ReleaseDate != "":
+		return "Unreleased, planned for " + n.Version.ReleaseDate
+	default:
+		return "Unreleased"
+	}
+}
+
+// Generate renders the release notes as markdown (default) or html
+func (n *ReleaseNotes) Generate(format string) (string, error) {
+	switch format {
+	case "html":
+		return n.generateHTML(), nil
+	case "", "markdown", "md":
+		return n.generateMarkdown(), nil
+	default:
+		return "", fmt.Errorf("unsupported release notes format: %s (expected markdown or html)", format)
+	}
+}
+
+// generateMarkdown renders one section per issue type with a linked bullet per issue
+func (n *ReleaseNotes) generateMarkdown() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# Release Notes - %s\n\n", n.Version.Name))
+	sb.WriteString(fmt.Sprintf("%s · %d issue(s)\n\n", n.releaseStatus(), n.Total()))
+	if n.Version.Description != "" {
+		sb.WriteString(n.Version.Description + "\n\n")
+	}
+
+	if len(n.Groups) == 0 {
+		sb.WriteString("No issues in this release.\n")
+		return sb.String()
+	}
+
+	for _, group := range n.Groups {
+		sb.WriteString(fmt.Sprintf("## %s\n\n", group.Type))
+		for _, issue := range group.Issues {
+			sb.WriteString(fmt.Sprintf("- [%s](%s) %s\n", issue.Key, n.IssueURL(issue.Key), issue.Fields.Summary))
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// generateHTML renders a summary box and one table per issue type
+func (n *ReleaseNotes) generateHTML() string {
+	var sb strings.Builder
+
+	title := "Release Notes - " + n.Version.Name
+	writeHTMLHeader(&sb, title)
+
+	sb.WriteString(fmt.Sprintf("<h1>🚀 %s</h1>\n", html.EscapeString(title)))
+	sb.WriteString(fmt.Sprintf("<div class=\"meta\">%s &middot; Generated: %s</div>\n",
+		html.EscapeString(n.releaseStatus()), time.Now().Format("2006-01-02 15:04:05")))
+
+	// Summary
+	sb.WriteString("<h2>Summary</h2>\n")
+	sb.WriteString("<div class=\"summary-box\">\n")
+	if n.Version.Description != "" {
+		sb.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(n.Version.Description)))
+	}
+	sb.WriteString(fmt.Sprintf("<p><strong>Total Issues:</strong> %d</p>\n", n.Total()))
+	for _, group := range n.Groups {
+		sb.WriteString(fmt.Sprintf("<p><strong>%s:</strong> %d</p>\n", html.EscapeString(group.Type), len(group.Issues)))
+	}
+	sb.WriteString("</div>\n")
+
+	// Per-type detail
+	for _, group := range n.Groups {
+		sb.WriteString(fmt.Sprintf("<h2>%s</h2>\n", html.EscapeString(group.Type)))
+		sb.WriteString("<table>\n")
+		sb.WriteString("<thead><tr><th>Key</th><th>Summary</th><th>Status</th></tr></thead>\n")
+		sb.WriteString("<tbody>\n")
+		for _, issue := range group.Issues {
+			status := "-"
+			if issue.Fields.Status != nil {
+				status = html.EscapeString(issue.Fields.Status.Name)
+			}
+			sb.WriteString(fmt.Sprintf("<tr><td><a class=\"key\" href=\"%s\">%s</a></td><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(n.IssueURL(issue.Key)),
+				html.EscapeString(issue.Key),
+				html.EscapeString(issue.Fields.Summary),
+				status))
+		}
+		sb.WriteString("</tbody>\n")
+		sb.WriteString("</table>\n")
+	}
+
+	writeHTMLFooter(&sb)
+
+	return sb.String()
+}
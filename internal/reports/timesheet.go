@@ -0,0 +1,213 @@
+package reports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+)
+
+// TimesheetEntry is time logged by one user on one ticket
+type TimesheetEntry struct {
+	User    string
+	Day     time.Time
+	Key     string
+	Summary string
+	Seconds int
+}
+
+// TimesheetRow is the total time a user logged on a ticket on a single day
+type TimesheetRow struct {
+	User    string
+	Day     string // YYYY-MM-DD
+	Key     string
+	Summary string
+	Seconds int
+}
+
+// TimesheetReport aggregates worklogs per user, day and ticket
+type TimesheetReport struct {
+	From time.Time
+	To   time.Time
+	Rows []TimesheetRow
+}
+
+// NewTimesheetReport aggregates entries into a timesheet covering from..to
+func NewTimesheetReport(entries []TimesheetEntry, from, to time.Time) *TimesheetReport {
+	type rowKey struct{ user, day, key string }
+	totals := make(map[rowKey]*TimesheetRow)
+
+	for _, e := range entries {
+		k := rowKey{e.User, e.Day.Format("2006-01-02"), e.Key}
+		row, ok := totals[k]
+		if !ok {
+			row = &TimesheetRow{User: k.user, Day: k.day, Key: k.key, Summary: e.Summary}
+			totals[k] = row
+		}
+		row.Seconds += e.Seconds
+	}
+
+	report := &TimesheetReport{From: from, To: to}
+	for _, row := range totals {
+		report.Rows = append(report.Rows, *row)
+	}
+	sort.Slice(report.Rows, func(i, j int) bool {
+		a, b := report.Rows[i], report.Rows[j]
+		if a.User != b.User {
+			return a.User < b.User
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.Key < b.Key
+	})
+
+	return report
+}
+
+// Users returns the users in the timesheet with their total logged seconds, in row order
+func (r *TimesheetReport) Users() ([]string, map[string]int) {
+	var users []string
+	totals := make(map[string]int)
+	for _, row := range r.Rows {
+		if _, ok := totals[row.User]; !ok {
+			users = append(users, row.User)
+		}
+		totals[row.User] += row.Seconds
+	}
+	return users, totals
+}
+
+// TotalSeconds returns the time logged across the whole timesheet
+func (r *TimesheetReport) TotalSeconds() int {
+	total := 0
+	for _, row := range r.Rows {
+		total += row.Seconds
+	}
+	return total
+}
+
+// Generate renders the timesheet as table (default), csv, or html
+func (r *TimesheetReport) Generate(format string) (string, error) {
+	switch format {
+	case "csv":
+		return r.generateCSV()
+	case "html":
+		return r.generateHTML(), nil
+	default:
+		return r.generateTable(), nil
+	}
+}
+
+// generateTable renders the timesheet with a subtotal per user
+func (r *TimesheetReport) generateTable() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "⏱️  TIMESHEET %s to %s\n\n", r.From.Format("2006-01-02"), r.To.Format("2006-01-02"))
+
+	if len(r.Rows) == 0 {
+		buf.WriteString("No time logged\n")
+		return buf.String()
+	}
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "USER\tDAY\tKEY\tSUMMARY\tTIME")
+	fmt.Fprintln(w, "----\t---\t---\t-------\t----")
+
+	users, totals := r.Users()
+	for _, user := range users {
+		for _, row := range r.Rows {
+			if row.User != user {
+				continue
+			}
+			summary := row.Summary
+			if len(summary) > 40 {
+				summary = summary[:37] + "..."
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", row.User, row.Day, row.Key, summary, jira.FormatTimeSpent(row.Seconds))
+		}
+		fmt.Fprintf(w, "\t\t\tTotal for %s\t%s\n", user, jira.FormatTimeSpent(totals[user]))
+		fmt.Fprintln(w, "\t\t\t\t")
+	}
+	w.Flush()
+
+	fmt.Fprintf(&buf, "Total: %s across %d user(s)\n", jira.FormatTimeSpent(r.TotalSeconds()), len(users))
+	return buf.String()
+}
+
+// generateCSV renders one line per user, day and ticket
+func (r *TimesheetReport) generateCSV() (string, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write([]string{"User", "Day", "Key", "Summary", "Seconds", "Hours"}); err != nil {
+		return "", err
+	}
+
+	for _, row := range r.Rows {
+		hours := strconv.FormatFloat(float64(row.Seconds)/jira.SecondsPerHour, 'f', 2, 64)
+		if err := writer.Write([]string{row.User, row.Day, row.Key, row.Summary, strconv.Itoa(row.Seconds), hours}); err != nil {
+			return "", err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// generateHTML renders a summary box and one table per user
+func (r *TimesheetReport) generateHTML() string {
+	var sb strings.Builder
+
+	writeHTMLHeader(&sb, "Timesheet")
+
+	sb.WriteString("<h1>⏱️ Timesheet</h1>\n")
+	sb.WriteString(fmt.Sprintf("<div class=\"meta\">%s to %s &middot; Generated: %s</div>\n",
+		r.From.Format("2006-01-02"), r.To.Format("2006-01-02"), time.Now().Format("2006-01-02 15:04:05")))
+
+	users, totals := r.Users()
+
+	// Summary
+	sb.WriteString("<h2>Summary</h2>\n")
+	sb.WriteString("<div class=\"summary-box\">\n")
+	sb.WriteString(fmt.Sprintf("<p><strong>Total Time:</strong> %s</p>\n", jira.FormatTimeSpent(r.TotalSeconds())))
+	for _, user := range users {
+		sb.WriteString(fmt.Sprintf("<p><strong>%s:</strong> %s</p>\n", html.EscapeString(user), jira.FormatTimeSpent(totals[user])))
+	}
+	sb.WriteString("</div>\n")
+
+	// Per-user detail
+	for _, user := range users {
+		sb.WriteString(fmt.Sprintf("<h2>%s</h2>\n", html.EscapeString(user)))
+		sb.WriteString("<table>\n")
+		sb.WriteString("<thead><tr><th>Day</th><th>Key</th><th>Summary</th><th>Time</th></tr></thead>\n")
+		sb.WriteString("<tbody>\n")
+		for _, row := range r.Rows {
+			if row.User != user {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("<tr><td>%s</td><td><span class=\"key\">%s</span></td><td>%s</td><td>%s</td></tr>\n",
+				row.Day,
+				html.EscapeString(row.Key),
+				html.EscapeString(row.Summary),
+				jira.FormatTimeSpent(row.Seconds)))
+		}
+		sb.WriteString(fmt.Sprintf("<tr class=\"total-row\"><td colspan=\"3\">Total</td><td>%s</td></tr>\n", jira.FormatTimeSpent(totals[user])))
+		sb.WriteString("</tbody>\n")
+		sb.WriteString("</table>\n")
+	}
+
+	writeHTMLFooter(&sb)
+
+	return sb.String()
+}
@@ -126,3 +126,73 @@ func (r *JSONRepository) Update(record jira.TicketRecord) error {
 
 	return r.Save(records)
 }
+
+// Delete removes a ticket record, and the references other records hold to it
+func (r *JSONRepository) Delete(key string) error {
+	records, err := r.Load()
+	if err != nil {
+		return err
+	}
+
+	kept := records[:0]
+	found := false
+	for _, record := range records {
+		if record.Key == key {
+			found = true
+			continue
+		}
+		if record.Parent == key {
+			record.Parent = ""
+		}
+		record.BlockedBy = removeKey(record.BlockedBy, key)
+		kept = append(kept, record)
+	}
+
+	if !found {
+		return fmt.Errorf("ticket not found: %s", key)
+	}
+
+	return r.Save(kept)
+}
+
+// Rename changes a ticket record's key, e.g. after the ticket moved to another
+// project, along with the references other records hold to it
+func (r *JSONRepository) Rename(oldKey, newKey string) error {
+	records, err := r.Load()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i := range records {
+		if records[i].Key == oldKey {
+			records[i].Key = newKey
+			found = true
+		}
+		if records[i].Parent == oldKey {
+			records[i].Parent = newKey
+		}
+		for j, blocker := range records[i].BlockedBy {
+			if blocker == oldKey {
+				records[i].BlockedBy[j] = newKey
+			}
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("ticket not found: %s", oldKey)
+	}
+
+	return r.Save(records)
+}
+
+// removeKey returns keys without key
+func removeKey(keys []string, key string) []string {
+	result := keys[:0]
+	for _, k := range keys {
+		if k != key {
+			result = append(result, k)
+		}
+	}
+	return result
+}
@@ -237,3 +237,64 @@ func TestJSONRepository_LoadEmptyFile(t *testing.T) {
 		t.Errorf("Load() from empty file returned %d records, expected 0", len(records))
 	}
 }
+
+func TestJSONRepository_DeleteAndRename(t *testing.T) {
+	tmpfile, err := os.CreateTemp("", "test*.json")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	tmpfile.Close()
+	defer os.Remove(tmpfile.Name())
+
+	repo, err := NewJSONRepository(tmpfile.Name())
+	if err != nil {
+		t.Fatalf("NewJSONRepository() error = %v", err)
+	}
+
+	err = repo.Save([]jira.TicketRecord{
+		{Key: "PROJ-1", Summary: "Epic"},
+		{Key: "PROJ-2", Summary: "Child", Parent: "PROJ-1", BlockedBy: []string{"PROJ-3"}},
+		{Key: "PROJ-3", Summary: "Blocker", BlockedBy: []string{}},
+	})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// Rename updates the record and the references to it
+	if err := repo.Rename("PROJ-1", "OPS-7"); err != nil {
+		t.Fatalf("Rename() error = %v", err)
+	}
+	child, err := repo.GetByKey("PROJ-2")
+	if err != nil {
+		t.Fatalf("GetByKey() error = %v", err)
+	}
+	if child.Parent != "OPS-7" {
+		t.Errorf("Rename() parent = %s, expected OPS-7", child.Parent)
+	}
+	if _, err := repo.GetByKey("OPS-7"); err != nil {
+		t.Errorf("GetByKey(OPS-7) error = %v", err)
+	}
+
+	// Delete removes the record and the references to it
+	if err := repo.Delete("PROJ-3"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	records, err := repo.GetAll()
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	if len(records) != 2 {
+		t.Errorf("Delete() left %d records, expected 2", len(records))
+	}
+	child, _ = repo.GetByKey("PROJ-2")
+	if len(child.BlockedBy) != 0 {
+		t.Errorf("Delete() blocked_by = %v, expected none", child.BlockedBy)
+	}
+
+	if err := repo.Delete("PROJ-3"); err == nil {
+		t.Error("Delete() of a missing ticket should fail")
+	}
+	if err := repo.Rename("PROJ-3", "OPS-8"); err == nil {
+		t.Error("Rename() of a missing ticket should fail")
+	}
+}
@@ -21,4 +21,10 @@ type Repository interface {
 
 	// Update updates an existing ticket record
 	Update(record jira.TicketRecord) error
+
+	// Delete removes a ticket record
+	Delete(key string) error
+
+	// Rename changes a ticket record's key
+	Rename(oldKey, newKey string) error
 }
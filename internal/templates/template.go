@@ -21,6 +21,10 @@ type Template struct {
 	Description string   `yaml:"template_description"` // Description for the issue
 	Labels      []string `yaml:"labels,omitempty"`
 	Components  []string `yaml:"components,omitempty"`
+
+	// DescriptionFormat is the format Description is written in (markdown by
+	// default, or wiki/raw); it is converted for the server when creating issues
+	DescriptionFormat string `yaml:"description_format,omitempty"`
 }
 
 // Loader handles template loading
@@ -89,16 +93,17 @@ func (l *Loader) Load(name string) (*Template, error) {
 // Render renders a template with the given variables
 func (t *Template) Render(vars map[string]string) (*Template, error) {
 	result := &Template{
-		Name:        t.Name,
-		Description: t.Description,
-		IssueType:   t.IssueType,
-		Priority:    t.Priority,
-		Labels:      t.Labels,
-		Components:  t.Components,
+		Name:              t.Name,
+		Description:       t.Description,
+		IssueType:         t.IssueType,
+		Priority:          t.Priority,
+		Labels:            t.Labels,
+		Components:        t.Components,
+		DescriptionFormat: t.DescriptionFormat,
 	}
 
 	// Render summary
-	summaryTpl, err := template.New("summary").Parse(t.Summary)
+	summaryTpl, err := template.New("summary").Option("missingkey=zero").Parse(t.Summary)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse summary template: %w", err)
 	}
@@ -110,7 +115,7 @@ func (t *Template) Render(vars map[string]string) (*Template, error) {
 	result.Summary = summaryBuf.String()
 
 	// Render description
-	descTpl, err := template.New("description").Parse(t.Description)
+	descTpl, err := template.New("description").Option("missingkey=zero").Parse(t.Description)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse description template: %w", err)
 	}
@@ -0,0 +1,425 @@
+package wiki
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/adf"
+)
+
+var (
+	headingPattern = regexp.MustCompile(`^h([1-6])\.\s*(.*)$`)
+	listPattern    = regexp.MustCompile(`^([*#]+|-)(?:\s+(.*))?$`)
+	codePattern    = regexp.MustCompile(`^\{(code|noformat)(?::([^}]*))?\}(.*)$`)
+	rulePattern    = regexp.MustCompile(`^-{4,}$`)
+)
+
+// ToADF parses Jira wiki markup into an ADF document
+func ToADF(markup string) *adf.Node {
+	markup = strings.ReplaceAll(markup, "\r\n", "\n")
+	return adf.NewDocument(parseBlocks(strings.Split(markup, "\n"))...)
+}
+
+// parseBlocks parses a sequence of lines into block nodes
+func parseBlocks(lines []string) []*adf.Node {
+	var blocks []*adf.Node
+
+	for i := 0; i < len(lines); {
+		trimmed := strings.TrimSpace(lines[i])
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case codePattern.MatchString(trimmed):
+			var node *adf.Node
+			node, i = parseCodeBlock(lines, i)
+			blocks = append(blocks, node)
+
+		case strings.HasPrefix(trimmed, "{quote}"):
+			var quoted []string
+			first := strings.TrimPrefix(trimmed, "{quote}")
+			for i++; ; i++ {
+				if end := strings.Index(first, "{quote}"); end >= 0 {
+					quoted = append(quoted, first[:end])
+					break
+				}
+				quoted = append(quoted, first)
+				if i >= len(lines) {
+					break
+				}
+				first = lines[i]
+			}
+			blocks = append(blocks, &adf.Node{Type: adf.TypeBlockquote, Content: nonEmpty(parseBlocks(quoted))})
+
+		case strings.HasPrefix(trimmed, "bq. "):
+			blocks = append(blocks, &adf.Node{Type: adf.TypeBlockquote, Content: []*adf.Node{
+				{Type: adf.TypeParagraph, Content: parseInline(strings.TrimPrefix(trimmed, "bq. "), nil)},
+			}})
+			i++
+
+		case headingPattern.MatchString(trimmed):
+			match := headingPattern.FindStringSubmatch(trimmed)
+			blocks = append(blocks, &adf.Node{
+				Type:    adf.TypeHeading,
+				Attrs:   map[string]interface{}{"level": int(match[1][0] - '0')},
+				Content: parseInline(match[2], nil),
+			})
+			i++
+
+		case rulePattern.MatchString(trimmed):
+			blocks = append(blocks, &adf.Node{Type: adf.TypeRule})
+			i++
+
+		case strings.HasPrefix(trimmed, "|"):
+			table := &adf.Node{Type: adf.TypeTable}
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|"); i++ {
+				table.Content = append(table.Content, parseTableRow(strings.TrimSpace(lines[i])))
+			}
+			blocks = append(blocks, table)
+
+		case listPattern.MatchString(trimmed):
+			var items []listLine
+			for ; i < len(lines); i++ {
+				match := listPattern.FindStringSubmatch(strings.TrimSpace(lines[i]))
+				if match == nil {
+					break
+				}
+				items = append(items, listLine{markers: match[1], text: match[2]})
+			}
+			blocks = append(blocks, buildLists(items, 0)...)
+
+		default:
+			paragraph := &adf.Node{Type: adf.TypeParagraph}
+			for start := i; i < len(lines); i++ {
+				if i > start && startsBlock(lines[i]) {
+					break
+				}
+				if i > start {
+					paragraph.Content = append(paragraph.Content, &adf.Node{Type: adf.TypeHardBreak})
+				}
+				paragraph.Content = append(paragraph.Content, parseInline(strings.TrimSpace(lines[i]), nil)...)
+			}
+			blocks = append(blocks, paragraph)
+		}
+	}
+
+	return blocks
+}
+
+// startsBlock reports whether a line ends a paragraph
+func startsBlock(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" ||
+		codePattern.MatchString(trimmed) ||
+		strings.HasPrefix(trimmed, "{quote}") ||
+		strings.HasPrefix(trimmed, "bq. ") ||
+		headingPattern.MatchString(trimmed) ||
+		rulePattern.MatchString(trimmed) ||
+		strings.HasPrefix(trimmed, "|") ||
+		listPattern.MatchString(trimmed)
+}
+
+// parseCodeBlock parses a {code} or {noformat} block starting at lines[i]
+func parseCodeBlock(lines []string, i int) (*adf.Node, int) {
+	match := codePattern.FindStringSubmatch(strings.TrimSpace(lines[i]))
+	closing := "{" + match[1] + "}"
+
+	node := &adf.Node{Type: adf.TypeCodeBlock}
+	if language := match[2]; language != "" && !strings.Contains(language, "=") {
+		node.Attrs = map[string]interface{}{"language": language}
+	}
+
+	var code []string
+	rest := match[3]
+	for i++; ; i++ {
+		if end := strings.Index(rest, closing); end >= 0 {
+			if rest[:end] != "" {
+				code = append(code, rest[:end])
+			}
+			break
+		}
+		if rest != "" || len(code) > 0 {
+			code = append(code, rest)
+		}
+		if i >= len(lines) {
+			break
+		}
+		rest = lines[i]
+	}
+
+	if text := strings.Join(code, "\n"); text != "" {
+		node.Content = []*adf.Node{{Type: adf.TypeText, Text: text}}
+	}
+	return node, i
+}
+
+// parseTableRow parses a "||header||" or "|cell|" table row
+func parseTableRow(line string) *adf.Node {
+	row := &adf.Node{Type: adf.TypeTableRow}
+
+	for len(line) > 0 {
+		cellType := adf.TypeTableCell
+		if strings.HasPrefix(line, "||") {
+			cellType = adf.TypeTableHeader
+			line = line[2:]
+		} else {
+			line = line[1:]
+		}
+
+		end := nextUnescapedPipe(line)
+		if end < 0 {
+			end = len(line)
+		}
+		cell := strings.TrimSpace(line[:end])
+		line = line[end:]
+		if cell == "" && line == "" {
+			break
+		}
+
+		row.Content = append(row.Content, &adf.Node{
+			Type:    cellType,
+			Content: []*adf.Node{{Type: adf.TypeParagraph, Content: parseInline(cell, nil)}},
+		})
+	}
+
+	return row
+}
+
+// nextUnescapedPipe returns the index of the next cell separator, skipping links like [a|b]
+func nextUnescapedPipe(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			if depth > 0 {
+				depth--
+			}
+		case '|':
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// listLine is one line of a wiki list, e.g. "*#" and "text" for "*# text"
+type listLine struct {
+	markers string
+	text    string
+}
+
+// buildLists builds nested lists from list lines at the given nesting depth
+func buildLists(lines []listLine, depth int) []*adf.Node {
+	var lists []*adf.Node
+	var current, item *adf.Node
+	var children []listLine
+
+	flushChildren := func() {
+		if item != nil && len(children) > 0 {
+			item.Content = append(item.Content, buildLists(children, depth+1)...)
+		}
+		children = nil
+	}
+
+	for _, line := range lines {
+		listType := adf.TypeBulletList
+		if line.markers[min(depth, len(line.markers)-1)] == '#' {
+			listType = adf.TypeOrderedList
+		}
+
+		if len(line.markers) > depth+1 && item != nil {
+			children = append(children, line)
+			continue
+		}
+
+		flushChildren()
+		if current == nil || current.Type != listType {
+			current = &adf.Node{Type: listType}
+			lists = append(lists, current)
+		}
+		item = &adf.Node{Type: adf.TypeListItem, Content: []*adf.Node{
+			{Type: adf.TypeParagraph, Content: parseInline(line.text, nil)},
+		}}
+		current.Content = append(current.Content, item)
+	}
+	flushChildren()
+
+	return lists
+}
+
+// parseInline parses inline wiki markup into text, mention and hard break nodes
+func parseInline(s string, marks []adf.Mark) []*adf.Node {
+	var nodes []*adf.Node
+	var buf strings.Builder
+
+	flush := func() {
+		if buf.Len() > 0 {
+			nodes = appendText(nodes, buf.String(), marks)
+			buf.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case strings.HasPrefix(s[i:], "\\\\"):
+			flush()
+			nodes = append(nodes, &adf.Node{Type: adf.TypeHardBreak})
+			i += 2
+			continue
+
+		case c == '\\' && isEscape(s, i):
+			buf.WriteByte(s[i+1])
+			i += 2
+			continue
+
+		case strings.HasPrefix(s[i:], "{{"):
+			if end := strings.Index(s[i+2:], "}}"); end >= 0 {
+				flush()
+				nodes = appendText(nodes, s[i+2:i+2+end], withMark(marks, adf.Mark{Type: adf.MarkCode}))
+				i += end + 4
+				continue
+			}
+
+		case c == '[':
+			if end := nextClosingBracket(s, i); end > i {
+				flush()
+				nodes = append(nodes, parseLink(s[i+1:end], marks)...)
+				i = end + 1
+				continue
+			}
+
+		case strings.IndexByte("*_-+", c) >= 0:
+			if end := findEffectEnd(s, i); end > i+1 {
+				flush()
+				nodes = append(nodes, parseInline(s[i+1:end], withMark(marks, adf.Mark{Type: effectMark(c)}))...)
+				i = end + 1
+				continue
+			}
+		}
+
+		buf.WriteByte(c)
+		i++
+	}
+
+	flush()
+	return nodes
+}
+
+// parseLink parses the inside of [text|url], [url] or [~accountid:ID]
+func parseLink(inner string, marks []adf.Mark) []*adf.Node {
+	if strings.HasPrefix(inner, "~") {
+		user := strings.TrimPrefix(inner, "~")
+		return []*adf.Node{{
+			Type: adf.TypeMention,
+			Attrs: map[string]interface{}{
+				"id":   strings.TrimPrefix(user, "accountid:"),
+				"text": "@" + strings.TrimPrefix(user, "accountid:"),
+			},
+		}}
+	}
+
+	text, href := inner, inner
+	if sep := nextUnescapedPipe(inner); sep >= 0 {
+		text, href = inner[:sep], strings.TrimSpace(inner[sep+1:])
+	}
+	link := adf.Mark{Type: adf.MarkLink, Attrs: map[string]interface{}{"href": href}}
+	if text == href {
+		return appendText(nil, text, withMark(marks, link))
+	}
+	return parseInline(text, withMark(marks, link))
+}
+
+// nextClosingBracket returns the index of the "]" closing the "[" at s[i], or -1
+func nextClosingBracket(s string, i int) int {
+	for j := i + 1; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '[':
+			return -1
+		case ']':
+			return j
+		}
+	}
+	return -1
+}
+
+// isEscape reports whether the backslash at s[i] escapes the next character
+// Only markup characters can be escaped, so paths like C:\temp keep their backslashes
+func isEscape(s string, i int) bool {
+	if i+1 >= len(s) {
+		return false
+	}
+	if isPunct(s[i+1]) {
+		return true
+	}
+	// escapeText escapes the first letter of "h1. title" and "bq. quote" at the start of a line
+	return (i == 0 || s[i-1] == '\n') && startsBlockMarkup(s[i+1:])
+}
+
+// findEffectEnd returns the index of the marker closing the effect opened at s[i], or -1
+// Markers only count at word boundaries, so "snake_case" and "2024-01-01" stay literal
+func findEffectEnd(s string, i int) int {
+	marker := s[i]
+	if i > 0 && isAlnum(s[i-1]) || i+1 >= len(s) || s[i+1] == ' ' || s[i+1] == marker {
+		return -1
+	}
+	for j := i + 2; j < len(s); j++ {
+		switch {
+		case s[j] == '\\':
+			j++
+		case s[j] == marker && s[j-1] != ' ' && (j+1 == len(s) || !isAlnum(s[j+1])):
+			return j
+		}
+	}
+	return -1
+}
+
+// effectMark returns the mark for a wiki effect marker
+func effectMark(marker byte) string {
+	switch marker {
+	case '*':
+		return adf.MarkStrong
+	case '_':
+		return adf.MarkEm
+	case '-':
+		return adf.MarkStrike
+	default:
+		return adf.MarkUnderline
+	}
+}
+
+// appendText appends text with marks, merging it into the previous node when the marks match
+func appendText(nodes []*adf.Node, text string, marks []adf.Mark) []*adf.Node {
+	if text == "" {
+		return nodes
+	}
+	if n := len(nodes); n > 0 && nodes[n-1].Type == adf.TypeText && len(nodes[n-1].Marks) == 0 && len(marks) == 0 {
+		nodes[n-1].Text += text
+		return nodes
+	}
+	return append(nodes, &adf.Node{Type: adf.TypeText, Text: text, Marks: marks})
+}
+
+// withMark returns a copy of marks with mark added
+func withMark(marks []adf.Mark, mark adf.Mark) []adf.Mark {
+	result := make([]adf.Mark, 0, len(marks)+1)
+	result = append(result, marks...)
+	return append(result, mark)
+}
+
+// nonEmpty ensures container nodes have at least one block, as ADF requires
+func nonEmpty(blocks []*adf.Node) []*adf.Node {
+	if len(blocks) == 0 {
+		return []*adf.Node{{Type: adf.TypeParagraph}}
+	}
+	return blocks
+}
@@ -0,0 +1,305 @@
+package wiki
+
+import (
+	"strings"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/adf"
+)
+
+// FromADF renders an ADF document (or any ADF node) as Jira wiki markup
+func FromADF(node *adf.Node) string {
+	if node == nil {
+		return ""
+	}
+	if node.Type == adf.TypeDoc {
+		return renderBlocks(node.Content, "\n\n")
+	}
+	return renderBlocks([]*adf.Node{node}, "\n\n")
+}
+
+// renderBlocks renders block nodes joined by sep, grouping stray inline nodes into paragraphs
+func renderBlocks(nodes []*adf.Node, sep string) string {
+	var parts []string
+	var inline []*adf.Node
+
+	flushInline := func() {
+		if len(inline) > 0 {
+			parts = append(parts, renderInline(inline))
+			inline = nil
+		}
+	}
+
+	for _, node := range nodes {
+		if adf.IsInline(node.Type) {
+			inline = append(inline, node)
+			continue
+		}
+		flushInline()
+		if block := renderBlock(node); block != "" {
+			parts = append(parts, block)
+		}
+	}
+	flushInline()
+
+	return strings.Join(parts, sep)
+}
+
+// renderBlock renders a single block node
+func renderBlock(node *adf.Node) string {
+	switch node.Type {
+	case adf.TypeParagraph:
+		return renderInline(node.Content)
+
+	case adf.TypeHeading:
+		level := node.AttrInt("level", 1)
+		if level < 1 || level > 6 {
+			level = 1
+		}
+		return "h" + string(rune('0'+level)) + ". " + renderInline(node.Content)
+
+	case adf.TypeBulletList, adf.TypeOrderedList, adf.TypeTaskList:
+		return renderList(node, "")
+
+	case adf.TypeCodeBlock:
+		open := "{code}"
+		if language := node.AttrString("language"); language != "" {
+			open = "{code:" + language + "}"
+		}
+		return open + "\n" + plainText(node) + "\n{code}"
+
+	case adf.TypeBlockquote:
+		return "{quote}\n" + renderBlocks(node.Content, "\n\n") + "\n{quote}"
+
+	case adf.TypeRule:
+		return "----"
+
+	case adf.TypeTable:
+		return renderTable(node)
+
+	default:
+		if len(node.Content) > 0 {
+			return renderBlocks(node.Content, "\n\n")
+		}
+		return escapeText(node.Text)
+	}
+}
+
+// renderList renders a list; nested lists extend the marker prefix ("*", "#", "*#", ...)
+func renderList(list *adf.Node, prefix string) string {
+	marker := prefix + "*"
+	if list.Type == adf.TypeOrderedList {
+		marker = prefix + "#"
+	}
+
+	var lines []string
+	for _, item := range list.Content {
+		var text []string
+		var nested []string
+		for _, child := range item.Content {
+			switch {
+			case child.Type == adf.TypeBulletList || child.Type == adf.TypeOrderedList:
+				nested = append(nested, renderList(child, marker))
+			case adf.IsInline(child.Type):
+				text = append(text, renderInline([]*adf.Node{child}))
+			default:
+				text = append(text, renderBlock(child))
+			}
+		}
+
+		line := marker
+		if joined := strings.Join(text, "\n"); joined != "" {
+			line += " " + joined
+		}
+		lines = append(lines, line)
+		lines = append(lines, nested...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderTable renders a table; header cells use "||" separators
+func renderTable(table *adf.Node) string {
+	var lines []string
+	for _, row := range table.Content {
+		var sb strings.Builder
+		sep := "|"
+		for _, cell := range row.Content {
+			sep = "|"
+			if cell.Type == adf.TypeTableHeader {
+				sep = "||"
+			}
+			text := strings.ReplaceAll(renderBlocks(cell.Content, " "), "\n", " ")
+			if text == "" {
+				text = " "
+			}
+			sb.WriteString(sep + text)
+		}
+		sb.WriteString(sep)
+		lines = append(lines, sb.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderInline renders inline nodes as wiki markup
+func renderInline(nodes []*adf.Node) string {
+	var sb strings.Builder
+
+	for _, node := range nodes {
+		switch node.Type {
+		case adf.TypeText:
+			sb.WriteString(applyMarks(node.Text, node.Marks))
+
+		case adf.TypeHardBreak:
+			sb.WriteString("\n")
+
+		case adf.TypeMention:
+			if id := node.AttrString("id"); id != "" {
+				sb.WriteString("[~accountid:" + id + "]")
+			} else {
+				sb.WriteString(escapeText(node.AttrString("text")))
+			}
+
+		case adf.TypeEmoji:
+			if text := node.AttrString("text"); text != "" {
+				sb.WriteString(text)
+			} else {
+				sb.WriteString(node.AttrString("shortName"))
+			}
+
+		case adf.TypeInlineCard:
+			sb.WriteString("[" + node.AttrString("url") + "]")
+
+		default:
+			if len(node.Content) > 0 {
+				sb.WriteString(renderInline(node.Content))
+			} else if text := node.AttrString("text"); text != "" {
+				sb.WriteString(escapeText(text))
+			} else {
+				sb.WriteString(escapeText(node.Text))
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// applyMarks wraps text in the wiki markup for its marks
+func applyMarks(text string, marks []adf.Mark) string {
+	var code bool
+	var href string
+	var em, strong, strike, underline bool
+	for _, mark := range marks {
+		switch mark.Type {
+		case adf.MarkCode:
+			code = true
+		case adf.MarkEm:
+			em = true
+		case adf.MarkStrong:
+			strong = true
+		case adf.MarkStrike:
+			strike = true
+		case adf.MarkUnderline:
+			underline = true
+		case adf.MarkLink:
+			href, _ = mark.Attrs["href"].(string)
+		}
+	}
+
+	if code {
+		text = "{{" + text + "}}"
+	} else {
+		text = escapeText(text)
+	}
+
+	// Effect markers must hug the text, so keep surrounding spaces outside
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	leading := text[:strings.Index(text, trimmed)]
+	trailing := text[len(leading)+len(trimmed):]
+
+	if em {
+		trimmed = "_" + trimmed + "_"
+	}
+	if strong {
+		trimmed = "*" + trimmed + "*"
+	}
+	if strike {
+		trimmed = "-" + trimmed + "-"
+	}
+	if underline {
+		trimmed = "+" + trimmed + "+"
+	}
+	if href != "" {
+		if trimmed == escapeText(href) {
+			trimmed = "[" + href + "]"
+		} else {
+			trimmed = "[" + trimmed + "|" + href + "]"
+		}
+	}
+
+	return leading + trimmed + trailing
+}
+
+// escapeText escapes characters that would otherwise be read as wiki markup
+// Effect characters are only escaped where they could open or close an effect
+func escapeText(text string) string {
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == '*' || c == '{' || c == '}' || c == '[' || c == ']' || c == '|':
+			sb.WriteByte('\\')
+		case (i == 0 || text[i-1] == '\n') && startsBlockMarkup(text[i:]):
+			// "# item", "- item" and "h1. title" at the start of a line start blocks
+			sb.WriteByte('\\')
+		case strings.IndexByte("_-+^~", c) >= 0 && isEffectBoundary(text, i):
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// isEffectBoundary reports whether text[i] sits where an effect marker would be recognized
+func isEffectBoundary(text string, i int) bool {
+	prev, next := byte(' '), byte(' ')
+	if i > 0 {
+		prev = text[i-1]
+	}
+	if i+1 < len(text) {
+		next = text[i+1]
+	}
+	opening := !isAlnum(prev) && next != ' ' && next != '\n'
+	closing := prev != ' ' && prev != '\n' && !isAlnum(next)
+	return (opening || closing) && !(prev == ' ' && next == ' ')
+}
+
+// startsBlockMarkup reports whether a line would be read as a list item or heading
+func startsBlockMarkup(line string) bool {
+	line = strings.SplitN(line, "\n", 2)[0]
+	return listPattern.MatchString(line) || headingPattern.MatchString(line) || strings.HasPrefix(line, "bq. ")
+}
+
+// plainText returns the concatenated text of a node and its descendants
+func plainText(node *adf.Node) string {
+	if node.Type == adf.TypeText {
+		return node.Text
+	}
+	var sb strings.Builder
+	for _, child := range node.Content {
+		sb.WriteString(plainText(child))
+	}
+	return sb.String()
+}
+
+// isAlnum reports whether c is an ASCII letter or digit
+func isAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// isPunct reports whether c is printable ASCII punctuation
+func isPunct(c byte) bool {
+	return c > ' ' && c < 0x7f && !isAlnum(c)
+}
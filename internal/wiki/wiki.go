@@ -0,0 +1,19 @@
+// Package wiki converts between Markdown and Jira wiki markup, the rich text
+// format JIRA Server, Data Center and the Cloud REST API v2 use for descriptions
+//
+// Both directions go through the ADF document model in internal/adf, so the
+// same constructs are supported: headings, lists, code blocks, quotes, rules,
+// tables, links, mentions and inline formatting
+package wiki
+
+import "github.com/clintonsteiner/jira-ticket-creator/internal/adf"
+
+// FromMarkdown converts Markdown text to Jira wiki markup
+func FromMarkdown(markdown string) string {
+	return FromADF(adf.FromMarkdown(markdown))
+}
+
+// ToMarkdown converts Jira wiki markup to Markdown
+func ToMarkdown(markup string) string {
+	return adf.ToMarkdown(ToADF(markup))
+}
@@ -0,0 +1,75 @@
+package wiki
+
+import (
+	"testing"
+)
+
+func TestFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected string
+	}{
+		{"Heading", "## Description", "h2. Description"},
+		{"Bullet list", "- one\n- two", "* one\n* two"},
+		{"Nested list", "1. first\n   - sub", "# first\n#* sub"},
+		{"Inline marks", "**bold**, *em*, ~~gone~~ and `code`", "*bold*, _em_, -gone- and {{code}}"},
+		{"Link", "[docs](https://example.com)", "[docs|https://example.com]"},
+		{"Bare link", "<https://example.com>", "[https://example.com]"},
+		{"Mention", "@[Jane](accountid:abc123)", "[~accountid:abc123]"},
+		{"Code block", "```go\nx := 1\n```", "{code:go}\nx := 1\n{code}"},
+		{"Quote", "> quoted", "{quote}\nquoted\n{quote}"},
+		{"Rule", "---", "----"},
+		{"Table", "| A | B |\n|---|---|\n| 1 | 2 |", "||A||B||\n|1|2|"},
+		{"Literal specials", "snake_case 2024-01-01 a - b", "snake_case 2024-01-01 a - b"},
+		{"Escaped specials", `\*not bold\* {x}`, `\*not bold\* \{x\}`},
+		{"Stars inside words", "2*3*4", `2\*3\*4`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromMarkdown(tt.markdown); got != tt.expected {
+				t.Errorf("FromMarkdown(%q) = %q, want %q", tt.markdown, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		markup   string
+		expected string
+	}{
+		{"Heading", "h3. Title", "### Title"},
+		{"Lists", "* one\n** nested\n* two", "- one\n  - nested\n- two"},
+		{"Ordered list", "# one\n# two", "1. one\n2. two"},
+		{"Inline marks", "*bold* _em_ -strike- {{code}}", "**bold** *em* ~~strike~~ `code`"},
+		{"Link with text", "see [docs|https://example.com]", "see [docs](https://example.com)"},
+		{"Code block", "{code:java}\nint x;\n{code}", "```java\nint x;\n```"},
+		{"Noformat", "{noformat}\nraw *text*\n{noformat}", "```\nraw *text*\n```"},
+		{"Quote", "{quote}\nwise words\n{quote}", "> wise words"},
+		{"Table", "||Name||Value||\n|a|1|", "| Name | Value |\n| --- | --- |\n| a | 1 |"},
+		{"Mention", "thanks [~accountid:abc123]", "thanks @[abc123](accountid:abc123)"},
+		{"Line break", "line one\\\\line two", "line one\nline two"},
+		{"Hyphenated words stay literal", "well-known 2024-01-01", "well-known 2024-01-01"},
+		{"Backslashes before letters stay literal", `C:\temp\new`, `C:\\temp\\new`},
+		{"Escaped markup", `\*not bold\*`, `\*not bold\*`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToMarkdown(tt.markup); got != tt.expected {
+				t.Errorf("ToMarkdown(%q) = %q, want %q", tt.markup, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	markdown := "## Steps to Reproduce\n\n1. Open the app\n2. Click **Save**\n\n## Environment\n\n- Version: 1.2.3\n- OS: linux\n\n```sh\nmake test\n```"
+
+	if got := ToMarkdown(FromMarkdown(markdown)); got != markdown {
+		t.Errorf("round trip = %q, want %q", got, markdown)
+	}
+}
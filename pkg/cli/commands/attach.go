@@ -0,0 +1,211 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// AttachOptions holds the options for the attach command
+type AttachOptions struct {
+	Key      string
+	Files    []string
+	List     bool
+	Download []string // Attachment IDs or filenames, or "all"
+	Dir      string
+	Delete   []string // Attachment IDs
+}
+
+// ExecuteAttachCommand uploads, lists, downloads, or deletes ticket attachments
+func ExecuteAttachCommand(ctx context.Context, v *viper.Viper, opts AttachOptions) error {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return err
+	}
+
+	if len(opts.Files) == 0 && !opts.List && len(opts.Download) == 0 && len(opts.Delete) == 0 {
+		return fmt.Errorf("provide files to upload, or one of --list, --download, --delete")
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	service := jira.NewAttachmentService(client)
+
+	if len(opts.Files) > 0 {
+		attachments, err := service.AddAttachmentFiles(ctx, opts.Key, opts.Files...)
+		if err != nil {
+			cli.PrintError(err)
+			return err
+		}
+		for _, a := range attachments {
+			fmt.Printf("📎 %s (%s)\n", a.Filename, formatSize(a.Size))
+		}
+		fmt.Printf("✅ Attached %d file(s) to %s\n", len(attachments), opts.Key)
+	}
+
+	for _, id := range opts.Delete {
+		if err := service.DeleteAttachment(ctx, id); err != nil {
+			cli.PrintError(err)
+			return err
+		}
+		fmt.Printf("✅ Attachment %s deleted\n", id)
+	}
+
+	if !opts.List && len(opts.Download) == 0 {
+		return nil
+	}
+
+	attachments, err := service.GetAttachments(ctx, opts.Key)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if opts.List {
+		printAttachments(opts.Key, attachments)
+	}
+
+	if len(opts.Download) > 0 {
+		return downloadAttachments(ctx, service, attachments, opts.Download, opts.Dir)
+	}
+
+	return nil
+}
+
+// printAttachments prints a ticket's attachments as a table
+func printAttachments(key string, attachments []jira.Attachment) {
+	if len(attachments) == 0 {
+		fmt.Printf("No attachments on %s\n", key)
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tFILENAME\tSIZE\tCREATED\tAUTHOR")
+	fmt.Fprintln(w, "--\t--------\t----\t-------\t------")
+	for _, a := range attachments {
+		author := ""
+		if a.Author != nil {
+			author = userDisplayName(a.Author)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", a.ID, a.Filename, formatSize(a.Size), a.Created, author)
+	}
+	w.Flush()
+
+	fmt.Printf("\n📊 %d attachment(s)\n", len(attachments))
+}
+
+// downloadAttachments saves the selected attachments into dir
+// Each selector is an attachment ID, a filename, or "all"
+func downloadAttachments(ctx context.Context, service *jira.AttachmentService, attachments []jira.Attachment, selectors []string, dir string) error {
+	var selected []jira.Attachment
+	for _, sel := range selectors {
+		found := false
+		for _, a := range attachments {
+			if sel == "all" || a.ID == sel || a.Filename == sel {
+				selected = append(selected, a)
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("attachment not found: %s", sel)
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	for _, a := range selected {
+		path := filepath.Join(dir, filepath.Base(a.Filename))
+		if err := downloadAttachment(ctx, service, a, path); err != nil {
+			cli.PrintError(err)
+			return err
+		}
+		fmt.Printf("📥 %s (%s)\n", path, formatSize(a.Size))
+	}
+
+	return nil
+}
+
+// downloadAttachment streams a single attachment to path, removing partial files on failure
+func downloadAttachment(ctx context.Context, service *jira.AttachmentService, a jira.Attachment, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	if err := service.DownloadAttachment(ctx, a, file); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+
+	return file.Close()
+}
+
+// formatSize formats a byte count for display
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// NewAttachCommand creates the "attach" command
+func NewAttachCommand() *cobra.Command {
+	var opts AttachOptions
+
+	cmd := &cobra.Command{
+		Use:   "attach KEY [FILE...]",
+		Short: "Upload, list, download, and delete ticket attachments",
+		Long:  "Upload files to a JIRA ticket, or list, download, and delete its existing attachments.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Key = args[0]
+			opts.Files = args[1:]
+
+			// Bind flags to viper
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			// Read values from flags
+			opts.List, _ = cmd.Flags().GetBool("list")
+			opts.Download, _ = cmd.Flags().GetStringSlice("download")
+			opts.Dir, _ = cmd.Flags().GetString("dir")
+			opts.Delete, _ = cmd.Flags().GetStringSlice("delete")
+
+			return ExecuteAttachCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.List, "list", false, "List the ticket's attachments")
+	cmd.Flags().StringSliceVar(&opts.Download, "download", []string{}, "Download attachments by ID or filename (use 'all' for every attachment)")
+	cmd.Flags().StringVar(&opts.Dir, "dir", ".", "Directory to save downloaded attachments to")
+	cmd.Flags().StringSliceVar(&opts.Delete, "delete", []string{}, "Delete attachments by ID")
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,14 +16,18 @@ import (
 
 // BatchCreateOptions holds options for batch create command
 type BatchCreateOptions struct {
-	InputFile string
-	Format    string
-	DryRun    bool
-	Verbose   bool
+	InputFile         string
+	Format            string
+	DryRun            bool
+	Verbose           bool
+	DescriptionFormat string
+	Sprint            string // Sprint ID, name, "active" or "next"
+	Board             string // Board used to find the sprint by name
+	Bulk              bool   // Create through the bulk endpoint instead of one request per ticket
 }
 
 // ExecuteBatchCreateCommand executes batch create
-func ExecuteBatchCreateCommand(v *viper.Viper, opts BatchCreateOptions) error {
+func ExecuteBatchCreateCommand(ctx context.Context, v *viper.Viper, opts BatchCreateOptions) error {
 	// Load configuration with flag overrides
 	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
@@ -51,15 +56,32 @@ func ExecuteBatchCreateCommand(v *viper.Viper, opts BatchCreateOptions) error {
 	fmt.Printf("📋 Loaded %d ticket(s) from %s\n", len(tickets), opts.InputFile)
 
 	// Create JIRA client and services
-	client := jira.NewClient(cfg.JIRA.URL, cfg.JIRA.Email, cfg.JIRA.Token)
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	if err := setDescriptionFormat(client, opts.DescriptionFormat); err != nil {
+		return err
+	}
 	validator := jira.NewValidator(client)
 
+	// Resolve the sprint up front so a typo fails before anything is created
+	var sprint *jira.Sprint
+	if opts.Sprint != "" {
+		sprint, err = resolveSprint(ctx, jira.NewAgileService(client), opts.Board, opts.Sprint, cfg.JIRA.Project)
+		if err != nil {
+			cli.PrintError(err)
+			return err
+		}
+	}
+
 	// Phase 1: Validation
 	fmt.Println("\n🔍 Phase 1: Validation")
 	fmt.Println("---------------------")
 
 	processor := batch.NewBatchProcessor(client, cfg.JIRA.Project)
-	validationResults := processor.ValidateTickets(tickets, validator)
+	processor.Bulk = opts.Bulk
+	validationResults := processor.ValidateTickets(ctx, tickets, validator)
 
 	validFailures := 0
 	for _, result := range validationResults {
@@ -88,26 +110,32 @@ func ExecuteBatchCreateCommand(v *viper.Viper, opts BatchCreateOptions) error {
 	fmt.Println("\n📝 Phase 2: Creation")
 	fmt.Println("-------------------")
 
-	createResults := processor.CreateTickets(tickets)
+	createResults := processor.CreateTickets(ctx, tickets)
 
 	createdCount := 0
 	for _, result := range createResults {
 		if result.Error == nil {
 			createdCount++
-			fmt.Printf("✅ [%d] %s -> %s\n", result.Index+1, result.TicketData.Summary, result.CreatedKey)
+			fmt.Printf("✅ [%d] %s -> %s%s\n", result.Index+1, result.TicketData.Summary, result.CreatedKey, batch.FormatRetries(result.Retries))
 		} else {
-			fmt.Printf("❌ [%d] %s: %v\n", result.Index+1, result.TicketData.Summary, result.Error)
+			fmt.Printf("❌ [%d] %s: %v%s\n", result.Index+1, result.TicketData.Summary, result.Error, batch.FormatRetries(result.Retries))
 		}
 	}
 
 	fmt.Printf("\n✅ Created %d ticket(s)\n", createdCount)
 
+	// Stop before linking if the run was interrupted
+	if err := ctx.Err(); err != nil {
+		fmt.Printf("\n⚠️  Interrupted: created %d/%d ticket(s) before cancellation\n", createdCount, len(tickets))
+		return err
+	}
+
 	// Phase 3: Linking
 	if createdCount > 0 {
 		fmt.Println("\n🔗 Phase 3: Linking")
 		fmt.Println("------------------")
 
-		linkResults := processor.LinkTickets(createResults)
+		linkResults := processor.LinkTickets(ctx, createResults)
 
 		if len(linkResults) > 0 {
 			for _, result := range linkResults {
@@ -119,15 +147,60 @@ func ExecuteBatchCreateCommand(v *viper.Viper, opts BatchCreateOptions) error {
 		}
 	}
 
+	// Phase 4: Attachments
+	if hasAttachments(createResults) {
+		fmt.Println("\n📎 Phase 4: Attachments")
+		fmt.Println("----------------------")
+
+		attachResults := processor.AttachFiles(ctx, createResults)
+
+		if len(attachResults) > 0 {
+			for _, result := range attachResults {
+				fmt.Printf("⚠️  %s: %v\n", result.CreatedKey, result.Error)
+			}
+			fmt.Printf("\n⚠️  %d upload error(s) (tickets were created)\n", len(attachResults))
+		} else {
+			fmt.Println("✅ All attachments uploaded successfully")
+		}
+	}
+
+	// Phase 5: Sprint
+	if sprint != nil && createdCount > 0 {
+		fmt.Printf("\n🏃 Phase 5: Sprint %s\n", sprint.Name)
+		fmt.Println("----------------------")
+
+		sprintResults := processor.AddToSprint(ctx, sprint.ID, createResults)
+
+		if len(sprintResults) > 0 {
+			fmt.Printf("⚠️  %v\n", sprintResults[0].Error)
+			fmt.Printf("\n⚠️  %d ticket(s) not added to the sprint (tickets were created)\n", len(sprintResults))
+		} else {
+			fmt.Printf("✅ Added %d ticket(s) to %s\n", createdCount, sprint.Name)
+		}
+	}
+
 	// Print summary
 	fmt.Println("\n📊 Summary")
 	fmt.Println("==========")
 	fmt.Printf("Input:   %s\n", opts.InputFile)
 	fmt.Printf("Created: %d/%d tickets\n", createdCount, len(tickets))
+	if stats := client.Stats(); stats.Retries > 0 {
+		fmt.Printf("Retries: %d across %d request(s)\n", stats.Retries, stats.Requests)
+	}
 
 	return nil
 }
 
+// hasAttachments reports whether any successfully created ticket has files to upload
+func hasAttachments(results []batch.ProcessResult) bool {
+	for _, result := range results {
+		if result.Error == nil && len(result.TicketData.Attachments) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // NewBatchCommand creates the "batch" command with full implementation
 func NewBatchCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -144,8 +217,8 @@ func NewBatchCommand() *cobra.Command {
 		Long: `Create multiple tickets from a CSV or JSON input file.
 
 CSV format (with headers):
-  summary,description,issue_type,priority,assignee,labels,components,blocked_by
-  "Ticket 1","Description",Task,High,"user@email.com","label1,label2","comp1",
+  summary,description,issue_type,priority,assignee,labels,components,blocked_by,parent_key,fix_versions,affects_versions,attachments
+  "Ticket 1","Description",Task,High,"user@email.com","label1,label2","comp1",,PROJ-10,2.4.0,,"logs/crash.log"
 
 JSON format (array of objects):
   [
@@ -157,9 +230,29 @@ JSON format (array of objects):
       "assignee": "user@email.com",
       "labels": ["label1", "label2"],
       "components": ["comp1"],
-      "blocked_by": ["PROJ-1"]
+      "blocked_by": ["PROJ-1"],
+      "parent_key": "PROJ-10",
+      "fix_versions": ["2.4.0"],
+      "affects_versions": ["2.3.1"],
+      "attachments": ["logs/crash.log", "screen.png"]
     }
   ]
+
+parent_key makes the ticket a child of that epic, or a sub-task of that issue when
+issue_type is Sub-task. fix_versions and affects_versions name versions of the
+project, which are checked before anything is created. Relative attachment paths
+are resolved against the input file's directory.
+
+Any other column or key sets the field with that name or ID, e.g. a "Story Points"
+column or "customfield_10011": "PROJ-5". Values are converted for the field's type.
+
+Before anything is created, every ticket is checked against the project's create
+screen: the issue type and priority must exist, required fields must be set, and
+components, versions and select options must be allowed values. All problems with
+a ticket are listed together; --dry-run stops after this check.
+
+Tickets are created 50 per request through JIRA's bulk endpoint. Rows the server
+rejects are retried one at a time so each failure is reported against its row.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Bind flags to viper
@@ -172,8 +265,12 @@ JSON format (array of objects):
 			opts.Format, _ = cmd.Flags().GetString("format")
 			opts.DryRun, _ = cmd.Flags().GetBool("dry-run")
 			opts.Verbose, _ = cmd.Flags().GetBool("verbose")
+			opts.DescriptionFormat, _ = cmd.Flags().GetString("description-format")
+			opts.Sprint, _ = cmd.Flags().GetString("sprint")
+			opts.Board, _ = cmd.Flags().GetString("board")
+			opts.Bulk, _ = cmd.Flags().GetBool("bulk")
 
-			return ExecuteBatchCreateCommand(viper.GetViper(), opts)
+			return ExecuteBatchCreateCommand(cmd.Context(), viper.GetViper(), opts)
 		},
 	}
 
@@ -181,6 +278,10 @@ JSON format (array of objects):
 	batchCreate.Flags().StringVar(&opts.Format, "format", "csv", "Input file format: csv or json (default: csv)")
 	batchCreate.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Validate tickets without creating them (useful for testing)")
 	batchCreate.Flags().BoolVar(&opts.Verbose, "verbose", false, "Show detailed output for each ticket validation")
+	batchCreate.Flags().StringVar(&opts.DescriptionFormat, "description-format", jira.DescriptionFormatMarkdown, descriptionFormatUsage)
+	batchCreate.Flags().StringVar(&opts.Sprint, "sprint", "", sprintFlagUsage)
+	batchCreate.Flags().StringVar(&opts.Board, "board", "", boardFlagUsage)
+	batchCreate.Flags().BoolVar(&opts.Bulk, "bulk", true, "Create tickets 50 per request through the bulk endpoint (--bulk=false creates them one at a time)")
 	batchCreate.MarkFlagRequired("input")
 
 	cmd.AddCommand(batchCreate)
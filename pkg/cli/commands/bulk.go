@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/batch"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/interactive"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+)
+
+// bulkFields are the fields fetched for the tickets a --jql query selects
+var bulkFields = []string{"project", "issuetype", "summary", "status", "assignee"}
+
+// BulkOptions holds the options for changing every ticket a JQL query matches
+type BulkOptions struct {
+	JQL              string
+	Concurrency      int
+	SkipConfirmation bool
+}
+
+// addBulkFlags adds the --jql selection flags to a command that changes tickets
+func addBulkFlags(cmd *cobra.Command, opts *BulkOptions) {
+	cmd.Flags().StringVar(&opts.JQL, "jql", "", "Apply to every ticket matching this JQL query instead of a single KEY")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", batch.DefaultMaxConcurrent, "Tickets changed at the same time with --jql")
+	cmd.Flags().BoolVarP(&opts.SkipConfirmation, "yes", "y", false, "Change the tickets matched by --jql without asking for confirmation")
+}
+
+// checkKeyOrJQL checks that exactly one of a KEY argument and --jql was given
+func checkKeyOrJQL(args []string, opts BulkOptions) error {
+	switch {
+	case len(args) == 0 && opts.JQL == "":
+		return fmt.Errorf("a ticket KEY or --jql is required")
+	case len(args) > 0 && opts.JQL != "":
+		return fmt.Errorf("give either a ticket KEY or --jql, not both")
+	}
+	return nil
+}
+
+// selectBulkIssues finds the tickets matching opts.JQL and prints them as a preview
+func selectBulkIssues(ctx context.Context, issueService *jira.IssueService, opts BulkOptions) ([]jira.Issue, error) {
+	var issues []jira.Issue
+	err := issueService.SearchAll(ctx, opts.JQL, jira.SearchOptions{Fields: bulkFields}, func(issue jira.Issue) error {
+		issues = append(issues, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("No tickets match the query")
+		return nil, nil
+	}
+
+	fmt.Printf("📋 %d ticket(s) match the query:\n\n", len(issues))
+	printBulkPreview(issues)
+	fmt.Println()
+
+	return issues, nil
+}
+
+// printBulkPreview prints the tickets a bulk change applies to
+func printBulkPreview(issues []jira.Issue) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "KEY\tTYPE\tSTATUS\tASSIGNEE\tSUMMARY")
+	fmt.Fprintln(w, "---\t----\t------\t--------\t-------")
+
+	for _, issue := range issues {
+		status := ""
+		if issue.Fields.Status != nil {
+			status = issue.Fields.Status.Name
+		}
+		assignee := "Unassigned"
+		if issue.Fields.Assignee != nil {
+			assignee = issue.Fields.Assignee.DisplayName
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			issue.Key,
+			issue.Fields.IssueType.Name,
+			status,
+			assignee,
+			issue.Fields.Summary)
+	}
+}
+
+// confirmBulk asks whether to apply a change to the selected tickets
+func confirmBulk(action string, count int, opts BulkOptions) bool {
+	if opts.SkipConfirmation {
+		return true
+	}
+	confirm, err := interactive.PromptConfirm(fmt.Sprintf("%s %d ticket(s)", action, count))
+	if err != nil || !confirm {
+		fmt.Println("Cancelled")
+		return false
+	}
+	return true
+}
+
+// runBulk applies fn to the selected tickets and prints the results
+// Returns an error when any ticket failed
+func runBulk(ctx context.Context, issues []jira.Issue, opts BulkOptions, fn batch.IssueFunc) error {
+	results := batch.ApplyToIssues(ctx, issues, opts.Concurrency, fn)
+	batch.PrintIssueResults(results)
+
+	failed := 0
+	for _, result := range results {
+		if result.Error != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d ticket(s) failed", failed, len(results))
+	}
+	return nil
+}
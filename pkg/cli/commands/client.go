@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+)
+
+// newJiraClient creates a JIRA client using the authentication configured in cfg
+func newJiraClient(cfg *config.Config) (*jira.Client, error) {
+	auth, err := jira.NewAuthenticator(jira.AuthOptions{
+		Type:         cfg.AuthType(),
+		Email:        cfg.JIRA.Email,
+		Token:        cfg.JIRA.Token,
+		ClientID:     cfg.Auth.ClientID,
+		ClientSecret: cfg.Auth.ClientSecret,
+		RefreshToken: cfg.Auth.RefreshToken,
+		TokenURL:     cfg.Auth.TokenURL,
+		Cookie:       cfg.Auth.Cookie,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Atlassian rotates refresh tokens, so keep the latest one on disk
+	if oauth, ok := auth.(*jira.OAuth2Auth); ok {
+		oauth.RefreshToken = loadRefreshToken(cfg.Auth.RefreshToken)
+		oauth.OnRefresh = func(refreshToken string) {
+			if err := saveRefreshToken(cfg.Auth.RefreshToken, refreshToken); err != nil {
+				fmt.Printf("⚠️  Warning: Failed to save refreshed OAuth token: %v\n", err)
+			}
+		}
+	}
+
+	client := jira.NewClientWithAuth(cfg.JIRA.URL, auth)
+	client.Email = cfg.JIRA.Email
+	client.Token = cfg.JIRA.Token
+	client.APIVersion = cfg.API.Version
+
+	// Apply retry policy overrides
+	if cfg.Retry.MaxAttempts > 0 {
+		client.Retry.MaxAttempts = cfg.Retry.MaxAttempts
+	}
+	if cfg.Retry.BaseDelay > 0 {
+		client.Retry.BaseDelay = cfg.Retry.BaseDelay
+	}
+	if cfg.Retry.MaxDelay > 0 {
+		client.Retry.MaxDelay = cfg.Retry.MaxDelay
+	}
+	if cfg.Retry.Budget > 0 {
+		client.Retry.Budget = cfg.Retry.Budget
+	}
+	if cfg.Retry.NoJitter {
+		client.Retry.Jitter = false
+	}
+
+	return client, nil
+}
+
+// setDescriptionFormat validates a --description-format value and applies it to client
+func setDescriptionFormat(client *jira.Client, format string) error {
+	parsed, err := jira.ParseDescriptionFormat(format)
+	if err != nil {
+		return err
+	}
+	client.DescriptionFormat = parsed
+	return nil
+}
+
+// descriptionFormatUsage is the help text for --description-format flags
+const descriptionFormatUsage = "Format of the description: markdown (converted to wiki markup or ADF for the server), wiki (Jira wiki markup), or raw (sent unchanged)"
+
+// oauthTokenCache is the on-disk record of the latest rotated refresh token
+// Seed is the configured refresh token it descends from, so changing the
+// configured token invalidates the cache
+type oauthTokenCache struct {
+	Seed         string `json:"seed"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// oauthTokenCachePath returns the path of the OAuth token cache file
+func oauthTokenCachePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".jira", "oauth-token.json"), nil
+}
+
+// loadRefreshToken returns the latest rotated refresh token for seed, or seed itself
+func loadRefreshToken(seed string) string {
+	path, err := oauthTokenCachePath()
+	if err != nil {
+		return seed
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return seed
+	}
+
+	var cache oauthTokenCache
+	if err := json.Unmarshal(data, &cache); err != nil || cache.Seed != seed || cache.RefreshToken == "" {
+		return seed
+	}
+
+	return cache.RefreshToken
+}
+
+// saveRefreshToken persists a rotated refresh token descended from seed
+func saveRefreshToken(seed, refreshToken string) error {
+	path, err := oauthTokenCachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(oauthTokenCache{Seed: seed, RefreshToken: refreshToken}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal token cache: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// CloneOptions holds the options for the clone command
+type CloneOptions struct {
+	Key          string
+	ToProject    string
+	WithSubtasks bool
+	WithLinks    bool
+}
+
+// ExecuteCloneCommand executes the clone command
+func ExecuteCloneCommand(ctx context.Context, v *viper.Viper, opts CloneOptions) error {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	result, err := jira.NewIssueService(client).CloneIssue(ctx, opts.Key, jira.CloneOptions{
+		ProjectKey:   opts.ToProject,
+		WithSubtasks: opts.WithSubtasks,
+		WithLinks:    opts.WithLinks,
+	})
+
+	// Record whatever was created, even when a later step failed
+	if result != nil {
+		fmt.Printf("✅ Cloned %s -> %s\n", opts.Key, result.Key)
+		saveCloneRecords(*result)
+		for _, subtask := range result.Subtasks {
+			fmt.Printf("   Sub-task: %s\n", subtask.Key)
+		}
+		if result.Links > 0 {
+			fmt.Printf("   Copied %d link(s)\n", result.Links)
+		}
+		fmt.Printf("🔗 View at: %s/browse/%s\n", cfg.JIRA.URL, result.Key)
+	}
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	return nil
+}
+
+// saveCloneRecords saves a clone and its cloned sub-tasks to the local record file
+func saveCloneRecords(result jira.CloneResult) {
+	projectKey, _ := jira.ExtractProjectKey(result.Key)
+	if err := saveTicketRecord(projectKey, result.Key, result.Summary, result.Parent); err != nil {
+		fmt.Printf("⚠️  Warning: Failed to save ticket record: %v\n", err)
+		return
+	}
+	for _, subtask := range result.Subtasks {
+		saveCloneRecords(subtask)
+	}
+}
+
+// NewCloneCommand creates the "clone" command
+func NewCloneCommand() *cobra.Command {
+	var opts CloneOptions
+
+	cmd := &cobra.Command{
+		Use:   "clone KEY",
+		Short: "Clone a ticket",
+		Long: `Copy a ticket, in its own project or into another one, and link the copy to the
+original with a "clones" link.
+
+The summary, description, type, priority and labels are copied. Components,
+versions, assignee and epic are only copied within the same project.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Key = args[0]
+
+			// Bind flags to viper
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			return ExecuteCloneCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ToProject, "to-project", "", "Project to clone into (default: the ticket's project)")
+	cmd.Flags().BoolVar(&opts.WithSubtasks, "with-subtasks", false, "Also clone the ticket's sub-tasks")
+	cmd.Flags().BoolVar(&opts.WithLinks, "with-links", false, "Copy the ticket's links to the clone")
+
+	return cmd
+}
@@ -0,0 +1,302 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// CommentOptions holds the options for the comment subcommands
+type CommentOptions struct {
+	Key               string
+	ID                string
+	Body              string
+	BodyFile          string
+	DescriptionFormat string
+	Visibility        string
+	Format            string
+}
+
+// newCommentService loads configuration and creates a comment service
+func newCommentService(v *viper.Viper, descriptionFormat string) (*jira.CommentService, error) {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return nil, err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := setDescriptionFormat(client, descriptionFormat); err != nil {
+		return nil, err
+	}
+
+	return jira.NewCommentService(client), nil
+}
+
+// readCommentBody returns the comment body from --body or --body-file ("-" reads stdin)
+func readCommentBody(body, bodyFile string) (string, error) {
+	if body != "" && bodyFile != "" {
+		return "", fmt.Errorf("use only one of --body or --body-file")
+	}
+
+	if bodyFile != "" {
+		var data []byte
+		var err error
+		if bodyFile == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(bodyFile)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read comment body: %w", err)
+		}
+		body = string(data)
+	}
+
+	if strings.TrimSpace(body) == "" {
+		return "", fmt.Errorf("comment body is required (use --body or --body-file)")
+	}
+	return body, nil
+}
+
+// ExecuteCommentAddCommand adds a comment to a ticket
+func ExecuteCommentAddCommand(ctx context.Context, v *viper.Viper, opts CommentOptions) error {
+	body, err := readCommentBody(opts.Body, opts.BodyFile)
+	if err != nil {
+		return err
+	}
+	visibility, err := jira.ParseVisibility(opts.Visibility)
+	if err != nil {
+		return err
+	}
+
+	service, err := newCommentService(v, opts.DescriptionFormat)
+	if err != nil {
+		return err
+	}
+
+	comment, err := service.AddComment(ctx, opts.Key, body, visibility)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Comment %s added to %s\n", comment.ID, opts.Key)
+	return nil
+}
+
+// ExecuteCommentListCommand lists the comments on a ticket
+func ExecuteCommentListCommand(ctx context.Context, v *viper.Viper, opts CommentOptions) error {
+	service, err := newCommentService(v, "")
+	if err != nil {
+		return err
+	}
+
+	comments, err := service.GetComments(ctx, opts.Key)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if opts.Format == "json" {
+		data, err := json.MarshalIndent(comments, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(comments) == 0 {
+		fmt.Printf("No comments on %s\n", opts.Key)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tAUTHOR\tCREATED\tVISIBILITY\tBODY")
+	fmt.Fprintln(w, "--\t------\t-------\t----------\t----")
+	for _, c := range comments {
+		author := ""
+		if c.Author != nil {
+			author = userDisplayName(c.Author)
+		}
+		visibility := "-"
+		if c.Visibility != nil {
+			visibility = c.Visibility.Type + ":" + c.Visibility.Value
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.ID, author, c.Created, visibility, commentPreview(c.Body))
+	}
+	w.Flush()
+
+	fmt.Printf("\n📊 %d comment(s)\n", len(comments))
+	return nil
+}
+
+// ExecuteCommentEditCommand replaces the body of a comment
+// The comment keeps its visibility unless --visibility is given; "none" removes it
+func ExecuteCommentEditCommand(ctx context.Context, v *viper.Viper, opts CommentOptions) error {
+	body, err := readCommentBody(opts.Body, opts.BodyFile)
+	if err != nil {
+		return err
+	}
+	visibility, err := jira.ParseVisibility(opts.Visibility)
+	if err != nil {
+		return err
+	}
+
+	service, err := newCommentService(v, opts.DescriptionFormat)
+	if err != nil {
+		return err
+	}
+
+	if opts.Visibility == "" {
+		existing, err := service.GetComment(ctx, opts.Key, opts.ID)
+		if err != nil {
+			cli.PrintError(err)
+			return err
+		}
+		visibility = existing.Visibility
+	}
+
+	if _, err := service.UpdateComment(ctx, opts.Key, opts.ID, body, visibility); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Comment %s on %s updated\n", opts.ID, opts.Key)
+	return nil
+}
+
+// ExecuteCommentDeleteCommand deletes a comment
+func ExecuteCommentDeleteCommand(ctx context.Context, v *viper.Viper, opts CommentOptions) error {
+	service, err := newCommentService(v, "")
+	if err != nil {
+		return err
+	}
+
+	if err := service.DeleteComment(ctx, opts.Key, opts.ID); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Comment %s deleted from %s\n", opts.ID, opts.Key)
+	return nil
+}
+
+// userDisplayName returns the most readable identifier for a user
+func userDisplayName(u *jira.User) string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != "" {
+		return u.Name
+	}
+	if u.EmailAddress != "" {
+		return u.EmailAddress
+	}
+	return u.AccountID
+}
+
+// commentPreview returns the first line of a comment body, truncated for table output
+func commentPreview(body string) string {
+	line := strings.TrimSpace(strings.SplitN(strings.TrimSpace(body), "\n", 2)[0])
+	if runes := []rune(line); len(runes) > 60 {
+		return string(runes[:57]) + "..."
+	}
+	return line
+}
+
+// NewCommentCommand creates the "comment" command with add, list, edit, and delete subcommands
+func NewCommentCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comment",
+		Short: "Add, list, edit, and delete ticket comments",
+		Long:  "Manage comments on JIRA tickets. Comment bodies are written in Markdown by default.",
+	}
+
+	// addBodyFlags registers the flags shared by add and edit
+	addBodyFlags := func(c *cobra.Command) {
+		c.Flags().String("body", "", "Comment text")
+		c.Flags().String("body-file", "", "Read the comment text from a file (use - for stdin)")
+		c.Flags().String("description-format", jira.DescriptionFormatMarkdown, "Format of the comment: markdown (converted to wiki markup or ADF for the server), wiki (Jira wiki markup), or raw (sent unchanged)")
+		c.Flags().String("visibility", "", "Restrict the comment to a project role or group (role:NAME or group:NAME, or none)")
+	}
+
+	// readBodyFlags reads the flags registered by addBodyFlags
+	readBodyFlags := func(c *cobra.Command, opts *CommentOptions) {
+		opts.Body, _ = c.Flags().GetString("body")
+		opts.BodyFile, _ = c.Flags().GetString("body-file")
+		opts.DescriptionFormat, _ = c.Flags().GetString("description-format")
+		opts.Visibility, _ = c.Flags().GetString("visibility")
+	}
+
+	addCmd := &cobra.Command{
+		Use:   "add KEY",
+		Short: "Add a comment to a ticket",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := CommentOptions{Key: args[0]}
+			readBodyFlags(cmd, &opts)
+			return ExecuteCommentAddCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+	addBodyFlags(addCmd)
+
+	listCmd := &cobra.Command{
+		Use:   "list KEY",
+		Short: "List the comments on a ticket",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := CommentOptions{Key: args[0]}
+			opts.Format, _ = cmd.Flags().GetString("format")
+			return ExecuteCommentListCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+	listCmd.Flags().String("format", "table", "Output format: table, json")
+
+	editCmd := &cobra.Command{
+		Use:   "edit KEY COMMENT_ID",
+		Short: "Replace the text of a comment",
+		Long:  "Replace the text of a comment. The comment keeps its visibility unless --visibility is given; use --visibility none to make it visible to everyone.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := CommentOptions{Key: args[0], ID: args[1]}
+			readBodyFlags(cmd, &opts)
+			return ExecuteCommentEditCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+	addBodyFlags(editCmd)
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete KEY COMMENT_ID",
+		Short: "Delete a comment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := CommentOptions{Key: args[0], ID: args[1]}
+			return ExecuteCommentDeleteCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	cmd.AddCommand(addCmd, listCmd, editCmd, deleteCmd)
+
+	return cmd
+}
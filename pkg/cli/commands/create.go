@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,25 +14,35 @@ import (
 	"github.com/clintonsteiner/jira-ticket-creator/internal/interactive"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/storage"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/templates"
 	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
 )
 
 // CreateOptions holds the options for the create command
 type CreateOptions struct {
-	Summary     string
-	Description string
-	Type        string
-	Priority    string
-	Assignee    string
-	Labels      []string
-	Components  []string
-	BlockedBy   []string
-	Interactive bool
-	Template    string
+	Summary           string
+	Description       string
+	Type              string
+	Priority          string
+	Assignee          string
+	Labels            []string
+	Components        []string
+	BlockedBy         []string
+	Interactive       bool
+	Template          string
+	DescriptionFormat string
+	Attachments       []string
+	Links             []string // "type:KEY", e.g. "relates to:PROJ-9"
+	Fields            []string // "Name=value", e.g. "Story Points=5"
+	Parent            string   // Parent epic, or parent issue of a sub-task
+	Sprint            string   // Sprint ID, name, "active" or "next"
+	Board             string   // Board used to find the sprint by name
+	FixVersions       []string // Version names
+	AffectsVersions   []string // Version names
 }
 
 // ExecuteCreateCommand executes the create command
-func ExecuteCreateCommand(v *viper.Viper, opts CreateOptions) error {
+func ExecuteCreateCommand(ctx context.Context, v *viper.Viper, opts CreateOptions) error {
 	// Load configuration with flag overrides
 	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
@@ -44,7 +55,13 @@ func ExecuteCreateCommand(v *viper.Viper, opts CreateOptions) error {
 	}
 
 	// Create JIRA client
-	client := jira.NewClient(cfg.JIRA.URL, cfg.JIRA.Email, cfg.JIRA.Token)
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	if err := setDescriptionFormat(client, opts.DescriptionFormat); err != nil {
+		return err
+	}
 
 	// Handle interactive mode
 	if opts.Interactive {
@@ -69,9 +86,66 @@ func ExecuteCreateCommand(v *viper.Viper, opts CreateOptions) error {
 	if opts.Summary == "" {
 		return fmt.Errorf("summary is required")
 	}
+
+	// Check attachments up front so a typo doesn't leave a ticket without its files
+	for _, path := range opts.Attachments {
+		if _, err := os.Stat(path); err != nil {
+			return fmt.Errorf("failed to attach %s: %w", path, err)
+		}
+	}
 	issueService := jira.NewIssueService(client)
 	linkService := jira.NewLinkService(client)
 
+	// Resolve --link types against the server before creating anything
+	links, err := resolveLinkSpecs(ctx, linkService, opts.Links)
+	if err != nil {
+		return err
+	}
+
+	customFields, err := resolveCustomFields(ctx, client, opts.Fields)
+	if err != nil {
+		return err
+	}
+
+	var sprint *jira.Sprint
+	if opts.Sprint != "" {
+		sprint, err = resolveSprint(ctx, jira.NewAgileService(client), opts.Board, opts.Sprint, cfg.JIRA.Project)
+		if err != nil {
+			return err
+		}
+	}
+
+	versionService := jira.NewVersionService(client)
+	fixVersions, err := versionService.ResolveVersions(ctx, cfg.JIRA.Project, opts.FixVersions)
+	if err != nil {
+		return err
+	}
+	affectsVersions, err := versionService.ResolveVersions(ctx, cfg.JIRA.Project, opts.AffectsVersions)
+	if err != nil {
+		return err
+	}
+
+	var assignee *jira.User
+	if opts.Assignee != "" {
+		assignee, err = jira.NewUserService(client).ResolveAssignee(ctx, opts.Assignee, cfg.JIRA.Project)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.Parent != "" {
+		parentFields, err := jira.NewParentService(client).ParentFields(ctx, opts.Parent, jira.IsSubtaskType(opts.Type))
+		if err != nil {
+			return err
+		}
+		if customFields == nil {
+			customFields = make(map[string]interface{})
+		}
+		for id, value := range parentFields {
+			customFields[id] = value
+		}
+	}
+
 	// Build issue fields
 	fields := jira.IssueFields{
 		Project: jira.Project{
@@ -91,11 +165,7 @@ func ExecuteCreateCommand(v *viper.Viper, opts CreateOptions) error {
 		}
 	}
 
-	if opts.Assignee != "" {
-		fields.Assignee = &jira.User{
-			EmailAddress: opts.Assignee,
-		}
-	}
+	fields.Assignee = assignee
 
 	if len(opts.Labels) > 0 {
 		fields.Labels = opts.Labels
@@ -109,8 +179,12 @@ func ExecuteCreateCommand(v *viper.Viper, opts CreateOptions) error {
 		fields.Components = components
 	}
 
+	fields.FixVersions = fixVersions
+	fields.Versions = affectsVersions
+	fields.CustomFields = customFields
+
 	// Create the issue
-	resp, err := issueService.CreateIssueWithFields(fields)
+	resp, err := issueService.CreateIssueWithFieldsContext(ctx, fields)
 	if err != nil {
 		cli.PrintError(err)
 		return err
@@ -121,14 +195,40 @@ func ExecuteCreateCommand(v *viper.Viper, opts CreateOptions) error {
 	// Link blocked-by issues
 	if len(opts.BlockedBy) > 0 {
 		for _, blocker := range opts.BlockedBy {
-			if err := linkService.LinkBlocks(blocker, ticketKey); err != nil {
+			if err := linkService.LinkBlocksContext(ctx, blocker, ticketKey); err != nil {
 				fmt.Printf("⚠️  Warning: Failed to link %s blocks %s: %v\n", blocker, ticketKey, err)
 			}
 		}
 	}
 
+	// Create generic links
+	for _, link := range links {
+		if err := linkService.LinkResolved(ctx, link.linkType, link.inward, ticketKey, link.key); err != nil {
+			fmt.Printf("⚠️  Warning: Failed to link %s %s %s: %v\n", ticketKey, link.description, link.key, err)
+		}
+	}
+
+	// Add to the sprint
+	if sprint != nil {
+		if err := jira.NewAgileService(client).MoveToSprint(ctx, sprint.ID, ticketKey); err != nil {
+			fmt.Printf("⚠️  Warning: Failed to add %s to sprint %s: %v\n", ticketKey, sprint.Name, err)
+		} else {
+			fmt.Printf("🏃 Added to sprint %s\n", sprint.Name)
+		}
+	}
+
+	// Upload attachments
+	if len(opts.Attachments) > 0 {
+		attachmentService := jira.NewAttachmentService(client)
+		if attachments, err := attachmentService.AddAttachmentFiles(ctx, ticketKey, opts.Attachments...); err != nil {
+			fmt.Printf("⚠️  Warning: Failed to upload attachments: %v\n", err)
+		} else {
+			fmt.Printf("📎 Attached %d file(s)\n", len(attachments))
+		}
+	}
+
 	// Save ticket record
-	err = saveTicketRecord(cfg.JIRA.Project, ticketKey, opts.Summary)
+	err = saveTicketRecord(cfg.JIRA.Project, ticketKey, opts.Summary, opts.Parent)
 	if err != nil {
 		fmt.Printf("⚠️  Warning: Failed to save ticket record: %v\n", err)
 	}
@@ -143,16 +243,59 @@ func ExecuteCreateCommand(v *viper.Viper, opts CreateOptions) error {
 	return nil
 }
 
-// saveTicketRecord saves the created ticket to the local record file
-func saveTicketRecord(projectKey, ticketKey, summary string) error {
-	homeDir, err := os.UserHomeDir()
+// applyTemplate renders the template named in opts.Template into opts
+// Fields whose flags were set explicitly (per changed) are left untouched
+func applyTemplate(opts *CreateOptions, vars map[string]string, changed func(string) bool) error {
+	tmpl, err := templates.NewLoader().Load(opts.Template)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]string{
+		"title":       opts.Summary,
+		"description": opts.Description,
+	}
+	for k, v := range vars {
+		data[k] = v
+	}
+
+	rendered, err := tmpl.Render(data)
 	if err != nil {
 		return err
 	}
 
+	opts.Summary = rendered.Summary
+	opts.Description = rendered.Description
+	if rendered.IssueType != "" && !changed("type") {
+		opts.Type = rendered.IssueType
+	}
+	if rendered.Priority != "" && !changed("priority") {
+		opts.Priority = rendered.Priority
+	}
+	if rendered.DescriptionFormat != "" && !changed("description-format") {
+		opts.DescriptionFormat = rendered.DescriptionFormat
+	}
+	opts.Labels = append(opts.Labels, rendered.Labels...)
+	opts.Components = append(opts.Components, rendered.Components...)
+
+	return nil
+}
+
+// openTicketRepository opens the local record file, ~/.jira/tickets.json
+func openTicketRepository() (*storage.JSONRepository, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
 	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
 
-	repo, err := storage.NewJSONRepository(recordFile)
+	return storage.NewJSONRepository(recordFile)
+}
+
+// saveTicketRecord saves the created ticket to the local record file
+func saveTicketRecord(projectKey, ticketKey, summary, parent string) error {
+	repo, err := openTicketRepository()
 	if err != nil {
 		return err
 	}
@@ -163,6 +306,7 @@ func saveTicketRecord(projectKey, ticketKey, summary string) error {
 		Status:    "To Do",
 		BlockedBy: []string{},
 		CreatedAt: time.Now(),
+		Parent:    parent,
 	}
 
 	return repo.Add(record)
@@ -193,8 +337,25 @@ func NewCreateCommand() *cobra.Command {
 			opts.BlockedBy, _ = cmd.Flags().GetStringSlice("blocked-by")
 			opts.Interactive, _ = cmd.Flags().GetBool("interactive")
 			opts.Template, _ = cmd.Flags().GetString("template")
+			opts.DescriptionFormat, _ = cmd.Flags().GetString("description-format")
+			opts.Attachments, _ = cmd.Flags().GetStringSlice("attach")
+			opts.Links, _ = cmd.Flags().GetStringArray("link")
+			opts.Fields, _ = cmd.Flags().GetStringArray("field")
+			opts.Parent, _ = cmd.Flags().GetString("parent")
+			opts.Sprint, _ = cmd.Flags().GetString("sprint")
+			opts.Board, _ = cmd.Flags().GetString("board")
+			opts.FixVersions, _ = cmd.Flags().GetStringSlice("fix-version")
+			opts.AffectsVersions, _ = cmd.Flags().GetStringSlice("affects-version")
+
+			// Fill in fields from the template; explicit flags take precedence
+			if opts.Template != "" {
+				vars, _ := cmd.Flags().GetStringToString("var")
+				if err := applyTemplate(&opts, vars, cmd.Flags().Changed); err != nil {
+					return err
+				}
+			}
 
-			return ExecuteCreateCommand(viper.GetViper(), opts)
+			return ExecuteCreateCommand(cmd.Context(), viper.GetViper(), opts)
 		},
 	}
 
@@ -202,14 +363,39 @@ func NewCreateCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.Description, "description", "", "Detailed description of the ticket")
 	cmd.Flags().StringVar(&opts.Type, "type", "Task", "Issue type (Task, Story, Bug, Epic, Subtask, etc. - must be valid for your JIRA project)")
 	cmd.Flags().StringVar(&opts.Priority, "priority", "Medium", "Priority level (Lowest, Low, Medium, High, Highest)")
-	cmd.Flags().StringVar(&opts.Assignee, "assignee", "", "Assignee email address (e.g., user@company.com)")
+	cmd.Flags().StringVar(&opts.Assignee, "assignee", "", assigneeFlagUsage)
 	cmd.Flags().StringSliceVar(&opts.Labels, "labels", []string{}, "Labels to categorize the ticket (comma-separated, e.g., --labels bug,urgent)")
 	cmd.Flags().StringSliceVar(&opts.Components, "component", []string{}, "Components affected (comma-separated, e.g., --component backend,api)")
+	cmd.Flags().StringVar(&opts.Parent, "parent", "", "Parent ticket key: the epic for a standard issue, or the parent issue when --type is Sub-task")
+	cmd.Flags().StringVar(&opts.Sprint, "sprint", "", sprintFlagUsage)
+	cmd.Flags().StringVar(&opts.Board, "board", "", boardFlagUsage)
+	cmd.Flags().StringSliceVar(&opts.FixVersions, "fix-version", []string{}, fixVersionFlagUsage)
+	cmd.Flags().StringSliceVar(&opts.AffectsVersions, "affects-version", []string{}, affectsVersionFlagUsage)
 	cmd.Flags().StringSliceVar(&opts.BlockedBy, "blocked-by", []string{}, "Ticket keys that block this one (comma-separated, e.g., --blocked-by PROJ-123,PROJ-124)")
 	cmd.Flags().BoolVarP(&opts.Interactive, "interactive", "i", false, "Interactive mode: prompts for all fields and fetches valid options from JIRA")
 	cmd.Flags().StringVar(&opts.Template, "template", "", "Use a predefined template for ticket creation")
+	cmd.Flags().StringToString("var", map[string]string{}, "Template variables (e.g., --var expected=\"No crash\",version=1.2); title and description default to --summary and --description")
+	cmd.Flags().StringVar(&opts.DescriptionFormat, "description-format", jira.DescriptionFormatMarkdown, descriptionFormatUsage)
+	cmd.Flags().StringArrayVar(&opts.Fields, "field", []string{}, fieldFlagUsage)
+	cmd.Flags().StringArrayVar(&opts.Links, "link", []string{}, "Link the new ticket to another, as TYPE:KEY (repeatable, e.g., --link \"relates to:PROJ-9\" --link \"is blocked by:PROJ-7\")")
+	cmd.Flags().StringSliceVar(&opts.Attachments, "attach", []string{}, "Files to attach to the new ticket (repeatable or comma-separated, e.g., --attach crash.log --attach screen.png)")
 
 	cmd.MarkFlagRequired("summary")
 
 	return cmd
 }
+
+// fieldFlagUsage is the help text for --field flags
+const fieldFlagUsage = "Set a custom or other field by name or ID, as NAME=VALUE (repeatable, e.g., --field \"Story Points=5\" --field customfield_10011=EPIC-1)"
+
+// resolveCustomFields resolves --field assignments into values keyed by field ID
+func resolveCustomFields(ctx context.Context, client *jira.Client, assignments []string) (map[string]interface{}, error) {
+	values, err := jira.ParseFieldAssignments(assignments)
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+	return jira.NewFieldService(client).ResolveFieldValues(ctx, values)
+}
+
+// assigneeFlagUsage is the help text for --assignee flags
+const assigneeFlagUsage = "Assignee email address, display name, or username (e.g., user@company.com); looked up as an assignable user of the project"
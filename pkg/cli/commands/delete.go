@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/interactive"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// DeleteOptions holds the options for the delete command
+type DeleteOptions struct {
+	Key              string
+	CascadeSubtasks  bool
+	SkipConfirmation bool
+}
+
+// ExecuteDeleteCommand executes the delete command
+func ExecuteDeleteCommand(ctx context.Context, v *viper.Viper, opts DeleteOptions) error {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	issueService := jira.NewIssueService(client)
+
+	issue, err := issueService.GetIssueWithOptions(ctx, opts.Key, jira.GetOptions{Fields: []string{"summary", "subtasks"}})
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	subtasks := issue.Fields.Subtasks
+	if len(subtasks) > 0 && !opts.CascadeSubtasks {
+		err := fmt.Errorf("%s has %d sub-task(s); use --cascade-subtasks to delete them too", opts.Key, len(subtasks))
+		cli.PrintError(err)
+		return err
+	}
+
+	if !opts.SkipConfirmation {
+		label := fmt.Sprintf("Delete %s \"%s\"", opts.Key, issue.Fields.Summary)
+		if len(subtasks) > 0 {
+			label += fmt.Sprintf(" and its %d sub-task(s)", len(subtasks))
+		}
+		confirm, err := interactive.PromptConfirm(label)
+		if err != nil || !confirm {
+			fmt.Println("Cancelled")
+			return nil
+		}
+	}
+
+	if err := issueService.DeleteIssue(ctx, opts.Key, opts.CascadeSubtasks); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	// Drop the deleted tickets from the local records
+	deleted := []string{opts.Key}
+	for _, subtask := range subtasks {
+		deleted = append(deleted, subtask.Key)
+	}
+	if err := deleteTicketRecords(deleted); err != nil {
+		fmt.Printf("⚠️  Warning: Failed to update ticket records: %v\n", err)
+	}
+
+	fmt.Printf("✅ Deleted %s", opts.Key)
+	if len(subtasks) > 0 {
+		fmt.Printf(" and %d sub-task(s)", len(subtasks))
+	}
+	fmt.Println()
+	return nil
+}
+
+// deleteTicketRecords removes the tracked records among keys
+func deleteTicketRecords(keys []string) error {
+	repo, err := openTicketRepository()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if _, err := repo.GetByKey(key); err != nil {
+			continue // Not tracked locally
+		}
+		if err := repo.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewDeleteCommand creates the "delete" command
+func NewDeleteCommand() *cobra.Command {
+	var opts DeleteOptions
+
+	cmd := &cobra.Command{
+		Use:   "delete KEY",
+		Short: "Delete a ticket",
+		Long: `Permanently delete a ticket after confirmation. A ticket with sub-tasks is only
+deleted with --cascade-subtasks, which deletes the sub-tasks too.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Key = args[0]
+
+			// Bind flags to viper
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			return ExecuteDeleteCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.CascadeSubtasks, "cascade-subtasks", false, "Also delete the ticket's sub-tasks")
+	cmd.Flags().BoolVarP(&opts.SkipConfirmation, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
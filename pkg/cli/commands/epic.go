@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// EpicOptions holds the options for the epic add-children command
+type EpicOptions struct {
+	Epic   string
+	Keys   []string
+	JQL    string
+	DryRun bool
+}
+
+// ExecuteEpicAddChildrenCommand re-parents existing tickets under an epic
+func ExecuteEpicAddChildrenCommand(ctx context.Context, v *viper.Viper, opts EpicOptions) error {
+	if len(opts.Keys) == 0 && opts.JQL == "" {
+		return fmt.Errorf("give ticket keys or --jql to select the children")
+	}
+
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	return addEpicChildren(ctx, client, opts)
+}
+
+// addEpicChildren sets the epic as the parent of every selected ticket
+// Sub-tasks and the epic itself are skipped, since they can't be epic children
+func addEpicChildren(ctx context.Context, client *jira.Client, opts EpicOptions) error {
+	issueService := jira.NewIssueService(client)
+
+	// Collect the children, fetching their types to skip sub-tasks
+	var children []jira.Issue
+	seen := make(map[string]bool)
+	collect := func(issue jira.Issue) error {
+		if seen[issue.Key] || issue.Key == opts.Epic {
+			return nil
+		}
+		seen[issue.Key] = true
+		if issue.Fields.IssueType.Subtask || jira.IsSubtaskType(issue.Fields.IssueType.Name) {
+			fmt.Printf("⚠️  Skipping sub-task %s\n", issue.Key)
+			return nil
+		}
+		children = append(children, issue)
+		return nil
+	}
+
+	getOpts := jira.GetOptions{Fields: []string{"summary", "issuetype"}}
+	for _, key := range opts.Keys {
+		issue, err := issueService.GetIssueWithOptions(ctx, key, getOpts)
+		if err != nil {
+			cli.PrintError(err)
+			return err
+		}
+		if err := collect(*issue); err != nil {
+			return err
+		}
+	}
+	if opts.JQL != "" {
+		if err := issueService.SearchAll(ctx, opts.JQL, jira.SearchOptions{Fields: getOpts.Fields}, collect); err != nil {
+			cli.PrintError(err)
+			return err
+		}
+	}
+
+	if len(children) == 0 {
+		fmt.Println("No tickets to add")
+		return nil
+	}
+
+	parentFields, err := jira.NewParentService(client).ParentFields(ctx, opts.Epic, false)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if opts.DryRun {
+		fmt.Printf("📋 Would add %d ticket(s) to %s:\n", len(children), opts.Epic)
+		for _, issue := range children {
+			fmt.Printf("  %s  %s\n", issue.Key, issue.Fields.Summary)
+		}
+		return nil
+	}
+
+	failed := 0
+	for _, issue := range children {
+		if err := issueService.UpdateFields(ctx, issue.Key, parentFields); err != nil {
+			fmt.Printf("❌ %s: %v\n", issue.Key, err)
+			failed++
+			continue
+		}
+		fmt.Printf("✅ %s → %s\n", issue.Key, opts.Epic)
+	}
+
+	fmt.Printf("\n📊 Added %d of %d ticket(s) to %s\n", len(children)-failed, len(children), opts.Epic)
+	if failed > 0 {
+		return fmt.Errorf("failed to add %d ticket(s) to %s", failed, opts.Epic)
+	}
+	return nil
+}
+
+// NewEpicCommand creates the "epic" command with the add-children subcommand
+func NewEpicCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epic",
+		Short: "Manage epic membership",
+		Long:  "Organize tickets under epics using the parent field (or Epic Link on older servers).",
+	}
+
+	var opts EpicOptions
+	addChildrenCmd := &cobra.Command{
+		Use:   "add-children EPIC [KEY...]",
+		Short: "Move existing tickets under an epic",
+		Long: `Make EPIC the parent of the given tickets and/or every ticket matching --jql, e.g.
+
+  epic add-children PROJ-10 PROJ-11 PROJ-12
+  epic add-children PROJ-10 --jql 'project = PROJ AND labels = checkout'
+
+Tickets already in another epic are moved. Sub-tasks are skipped.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Epic = args[0]
+			opts.Keys = args[1:]
+
+			// Bind flags to viper
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			opts.JQL, _ = cmd.Flags().GetString("jql")
+			opts.DryRun, _ = cmd.Flags().GetBool("dry-run")
+
+			return ExecuteEpicAddChildrenCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+	addChildrenCmd.Flags().StringVar(&opts.JQL, "jql", "", "JQL selecting the tickets to add")
+	addChildrenCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "List the tickets that would be moved without changing them")
+
+	cmd.AddCommand(addChildrenCmd)
+
+	return cmd
+}
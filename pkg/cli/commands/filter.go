@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+)
+
+// FilterOptions holds the filter flags that are compiled to JQL
+type FilterOptions struct {
+	Project      string   // From the global --project flag, only when given on the command line
+	Statuses     []string // Any of
+	Assignee     string   // User, "me" or "none"
+	Types        []string // Any of
+	Labels       []string // Any of
+	UpdatedSince string   // YYYY-MM-DD or a relative offset such as 7d
+}
+
+// addFilterFlags adds the filter flags to a command that searches for tickets
+func addFilterFlags(cmd *cobra.Command, opts *FilterOptions) {
+	cmd.Flags().StringSliceVar(&opts.Statuses, "status", []string{}, "Only tickets in any of these statuses (comma-separated or repeatable)")
+	cmd.Flags().StringVar(&opts.Assignee, "assignee", "", "Only tickets assigned to this user (email, name, \"me\", or \"none\" for unassigned)")
+	cmd.Flags().StringSliceVar(&opts.Types, "type", []string{}, "Only tickets of any of these issue types (comma-separated or repeatable)")
+	cmd.Flags().StringSliceVar(&opts.Labels, "label", []string{}, "Only tickets with any of these labels (comma-separated or repeatable)")
+	cmd.Flags().StringVar(&opts.UpdatedSince, "updated-since", "", "Only tickets updated since a date (YYYY-MM-DD) or within a period (e.g., 7d, 2w, 12h)")
+}
+
+// readFilterFlags reads the filter flags, taking --project only when it was given
+// so the configured default project does not narrow the search
+func readFilterFlags(cmd *cobra.Command, opts *FilterOptions) {
+	if cmd.Flags().Changed("project") {
+		opts.Project, _ = cmd.Flags().GetString("project")
+	}
+	opts.Statuses, _ = cmd.Flags().GetStringSlice("status")
+	opts.Assignee, _ = cmd.Flags().GetString("assignee")
+	opts.Types, _ = cmd.Flags().GetStringSlice("type")
+	opts.Labels, _ = cmd.Flags().GetStringSlice("label")
+	opts.UpdatedSince, _ = cmd.Flags().GetString("updated-since")
+}
+
+// IsEmpty reports whether no filter was given
+func (f FilterOptions) IsEmpty() bool {
+	return f.Project == "" && len(f.Statuses) == 0 && f.Assignee == "" &&
+		len(f.Types) == 0 && len(f.Labels) == 0 && f.UpdatedSince == ""
+}
+
+// buildFilterQuery compiles a hand-written query and the filters into one JQL query
+// Named assignees are looked up so the query works with Cloud accountIds
+func buildFilterQuery(ctx context.Context, client *jira.Client, query string, f FilterOptions) (*jql.Query, error) {
+	q := jql.New().WhereJQL(query)
+
+	if f.Project != "" {
+		q.Where(jql.Eq("project", jql.String(f.Project)))
+	}
+	q.Where(jql.In("status", jql.Strings(f.Statuses...)...))
+	q.Where(jql.In("issuetype", jql.Strings(f.Types...)...))
+	q.Where(jql.In("labels", jql.Strings(f.Labels...)...))
+
+	switch assignee := strings.TrimSpace(f.Assignee); strings.ToLower(assignee) {
+	case "":
+	case "me", "currentuser()":
+		q.Where(jql.Eq("assignee", jql.CurrentUser()))
+	case "none", "unassigned":
+		q.Where(jql.IsEmpty("assignee"))
+	default:
+		user, err := jira.NewUserService(client).ResolveUser(ctx, assignee, "")
+		if err != nil {
+			return nil, err
+		}
+		id := user.AccountID
+		if id == "" {
+			id = user.Name
+		}
+		q.Where(jql.Eq("assignee", jql.String(id)))
+	}
+
+	if f.UpdatedSince != "" {
+		since, err := parseSince(f.UpdatedSince)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --updated-since: %w", err)
+		}
+		q.Where(jql.Gte("updated", since))
+	}
+
+	return q, nil
+}
+
+// parseSince parses a date (YYYY-MM-DD) or a period before now such as 7d or 2w
+func parseSince(value string) (jql.Value, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(jql.DateLayout, value); err == nil {
+		return jql.Date(t), nil
+	}
+	if !strings.HasPrefix(value, "-") && !strings.HasPrefix(value, "+") {
+		value = "-" + value // A period since now is in the past
+	}
+	return jql.RelativeDate(value)
+}
@@ -3,12 +3,10 @@ package commands
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	"github.com/clintonsteiner/jira-ticket-creator/internal/reports"
-	"github.com/clintonsteiner/jira-ticket-creator/internal/storage"
 )
 
 // NewGanttCommand creates the "gantt" command for Gantt chart visualization
@@ -23,33 +21,24 @@ func NewGanttCommand() *cobra.Command {
 		Long: `Generate Gantt chart visualization showing tickets scheduled by assigned resource.
 Displays ticket status, timeline, and workload distribution across team members.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeGanttCommand(outputFormat, outputFile, weeks)
+			where, _ := cmd.Flags().GetString("where")
+			return executeGanttCommand(outputFormat, outputFile, weeks, where)
 		},
 	}
 
 	cmd.Flags().StringVar(&outputFormat, "format", "ascii", "Output format: ascii (ASCII art), mermaid (Mermaid diagram), html (HTML file)")
 	cmd.Flags().StringVar(&outputFile, "output", "", "Output file path (optional, default: print to stdout)")
 	cmd.Flags().IntVar(&weeks, "weeks", 2, "Number of weeks to display in the timeline (for ASCII format)")
+	addWhereFlag(cmd)
 
 	return cmd
 }
 
 // executeGanttCommand executes the gantt command
-func executeGanttCommand(format string, outputFile string, weeks int) error {
-	homeDir, err := os.UserHomeDir()
+func executeGanttCommand(format string, outputFile string, weeks int, where string) error {
+	records, err := loadTrackedTickets(where)
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
-	}
-
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
-	}
-
-	records, err := repo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
+		return err
 	}
 
 	if len(records) == 0 {
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -24,10 +25,12 @@ type ImportOptions struct {
 	DryRun         bool
 	UpdateExisting bool
 	MappingPath    string
+	MaxResults     int
+	Filters        FilterOptions
 }
 
 // ExecuteImportCommand executes the import command
-func ExecuteImportCommand(v *viper.Viper, opts ImportOptions) error {
+func ExecuteImportCommand(ctx context.Context, v *viper.Viper, opts ImportOptions) error {
 	// Load configuration with flag overrides
 	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
@@ -39,8 +42,8 @@ func ExecuteImportCommand(v *viper.Viper, opts ImportOptions) error {
 		return err
 	}
 
-	if opts.JQL == "" {
-		return fmt.Errorf("--jql flag is required")
+	if opts.JQL == "" && opts.Filters.IsEmpty() {
+		return fmt.Errorf("--jql or a filter such as --status is required")
 	}
 
 	// Load project mapping
@@ -63,26 +66,20 @@ func ExecuteImportCommand(v *viper.Viper, opts ImportOptions) error {
 	}
 
 	// Create JIRA client
-	client := jira.NewClient(cfg.JIRA.URL, cfg.JIRA.Email, cfg.JIRA.Token)
-	issueService := jira.NewIssueService(client)
-
-	// Execute JQL query
-	issues, err := issueService.SearchIssues(opts.JQL, 0, 1000)
+	client, err := newJiraClient(cfg)
 	if err != nil {
-		cli.PrintError(err)
 		return err
 	}
+	issueService := jira.NewIssueService(client)
 
-	if len(issues) == 0 {
-		fmt.Println("ℹ️  No issues found matching the query")
-		return nil
+	query, err := buildFilterQuery(ctx, client, opts.JQL, opts.Filters)
+	if err != nil {
+		return err
 	}
 
-	fmt.Printf("📥 Found %d issue(s) to import\n", len(issues))
-
-	// Create ticket records from issues
-	records := make([]jira.TicketRecord, 0, len(issues))
-	for _, issue := range issues {
+	// Execute JQL query, converting each page of issues to ticket records as it arrives
+	var records []jira.TicketRecord
+	err = issueService.SearchAll(ctx, query.String(), jira.SearchOptions{MaxResults: opts.MaxResults}, func(issue jira.Issue) error {
 		project := opts.MapProject
 		if project == "" {
 			// Try to find project from key prefix
@@ -106,6 +103,10 @@ func ExecuteImportCommand(v *viper.Viper, opts ImportOptions) error {
 			Project:   project,
 		}
 
+		if issue.Fields.Parent != nil {
+			record.Parent = issue.Fields.Parent.Key
+		}
+
 		if issue.Fields.Assignee != nil && issue.Fields.Assignee.Name != "" {
 			record.Assignee = issue.Fields.Assignee.Name
 		}
@@ -115,8 +116,20 @@ func ExecuteImportCommand(v *viper.Viper, opts ImportOptions) error {
 		}
 
 		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		cli.PrintError(err)
+		return err
 	}
 
+	if len(records) == 0 {
+		fmt.Println("ℹ️  No issues found matching the query")
+		return nil
+	}
+
+	fmt.Printf("📥 Found %d issue(s) to import\n", len(records))
+
 	// Display summary
 	fmt.Printf("\n📋 Import Summary:\n")
 	fmt.Printf("   Total: %d\n", len(records))
@@ -239,7 +252,10 @@ Examples:
     --map-rule "PROJ->backend" --map-rule "BACK->backend"
 
   # Update existing imported tickets
-  import --jql "status = 'In Progress'" --update-existing`,
+  import --jql "status = 'In Progress'" --update-existing
+
+  # Select tickets with filters instead of JQL
+  import --project PROJ --status "In Progress" --assignee me --updated-since 14d`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Bind flags to viper
 			if err := viper.BindPFlags(cmd.Flags()); err != nil {
@@ -253,19 +269,21 @@ Examples:
 			opts.DryRun, _ = cmd.Flags().GetBool("dry-run")
 			opts.UpdateExisting, _ = cmd.Flags().GetBool("update-existing")
 			opts.MappingPath, _ = cmd.Flags().GetString("mapping-path")
+			opts.MaxResults, _ = cmd.Flags().GetInt("max-results")
+			readFilterFlags(cmd, &opts.Filters)
 
-			return ExecuteImportCommand(viper.GetViper(), opts)
+			return ExecuteImportCommand(cmd.Context(), viper.GetViper(), opts)
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.JQL, "jql", "", "JQL query to select tickets to import (REQUIRED). Example: 'project = PROJ AND status != Done'")
+	cmd.Flags().StringVar(&opts.JQL, "jql", "", "JQL query to select tickets to import, combined with any filter flags. Example: 'project = PROJ AND status != Done'")
 	cmd.Flags().StringVar(&opts.MapProject, "map-project", "", "Logical project name to assign to all imported tickets (simplifies grouping)")
 	cmd.Flags().StringSliceVar(&opts.MapRules, "map-rule", []string{}, "Inline mapping rules (repeatable). Format: PREFIX->project. Example: --map-rule PROJ->backend --map-rule FRONT->frontend")
 	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Preview what would be imported without saving to disk")
 	cmd.Flags().BoolVar(&opts.UpdateExisting, "update-existing", false, "Update existing tickets if they already exist locally")
 	cmd.Flags().StringVar(&opts.MappingPath, "mapping-path", "", "Path to project mapping JSON file (default: ~/.jira/project-mapping.json)")
-
-	cmd.MarkFlagRequired("jql")
+	cmd.Flags().IntVar(&opts.MaxResults, "max-results", 0, "Maximum number of tickets to import (0 = all)")
+	addFilterFlags(cmd, &opts.Filters)
 
 	return cmd
 }
@@ -0,0 +1,262 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// LinkOptions holds the options for the link subcommands
+type LinkOptions struct {
+	Key    string
+	Type   string
+	Target string
+	IDs    []string
+	Format string
+}
+
+// linkSpec is a --link value resolved against the server's link types
+type linkSpec struct {
+	linkType    *jira.LinkType
+	inward      bool
+	description string
+	key         string
+}
+
+// parseLinkSpec splits a "type:KEY" value into its type and issue key
+func parseLinkSpec(spec string) (string, string, error) {
+	i := strings.LastIndex(spec, ":")
+	if i <= 0 || i == len(spec)-1 {
+		return "", "", fmt.Errorf("invalid link %q (use TYPE:KEY, e.g. \"blocks:PROJ-9\")", spec)
+	}
+	return strings.TrimSpace(spec[:i]), strings.TrimSpace(spec[i+1:]), nil
+}
+
+// resolveLinkSpecs parses and validates --link values, fetching link types only when needed
+func resolveLinkSpecs(ctx context.Context, service *jira.LinkService, specs []string) ([]linkSpec, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	types, err := service.GetLinkTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]linkSpec, 0, len(specs))
+	for _, spec := range specs {
+		name, key, err := parseLinkSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		linkType, inward, err := jira.MatchLinkType(types, name)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, linkSpec{linkType: linkType, inward: inward, description: name, key: key})
+	}
+	return links, nil
+}
+
+// newLinkService loads configuration and creates a link service
+func newLinkService(v *viper.Viper) (*jira.LinkService, error) {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return nil, err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return jira.NewLinkService(client), nil
+}
+
+// ExecuteLinkAddCommand links two tickets with a server-validated link type
+func ExecuteLinkAddCommand(ctx context.Context, v *viper.Viper, opts LinkOptions) error {
+	service, err := newLinkService(v)
+	if err != nil {
+		return err
+	}
+
+	linkType, inward, err := service.ResolveLinkType(ctx, opts.Type)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if err := service.LinkResolved(ctx, linkType, inward, opts.Key, opts.Target); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	description := linkType.Outward
+	if inward {
+		description = linkType.Inward
+	}
+	fmt.Printf("✅ %s %s %s\n", opts.Key, description, opts.Target)
+	return nil
+}
+
+// ExecuteLinkListCommand lists the links on a ticket
+func ExecuteLinkListCommand(ctx context.Context, v *viper.Viper, opts LinkOptions) error {
+	service, err := newLinkService(v)
+	if err != nil {
+		return err
+	}
+
+	links, err := service.ListLinks(ctx, opts.Key)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if opts.Format == "json" {
+		data, err := json.MarshalIndent(links, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(links) == 0 {
+		fmt.Printf("No links on %s\n", opts.Key)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tRELATION\tKEY\tSTATUS\tSUMMARY")
+	fmt.Fprintln(w, "--\t--------\t---\t------\t-------")
+	for i := range links {
+		description, issue := links[i].Describe()
+		if issue == nil {
+			continue
+		}
+		status := ""
+		if issue.Fields.Status != nil {
+			status = issue.Fields.Status.Name
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", links[i].ID, description, issue.Key, status, commentPreview(issue.Fields.Summary))
+	}
+	w.Flush()
+
+	fmt.Printf("\n📊 %d link(s)\n", len(links))
+	return nil
+}
+
+// ExecuteLinkTypesCommand lists the link types configured on the server
+func ExecuteLinkTypesCommand(ctx context.Context, v *viper.Viper) error {
+	service, err := newLinkService(v)
+	if err != nil {
+		return err
+	}
+
+	types, err := service.GetLinkTypes(ctx)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tOUTWARD\tINWARD")
+	fmt.Fprintln(w, "----\t-------\t------")
+	for _, t := range types {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t.Name, t.Outward, t.Inward)
+	}
+	w.Flush()
+	return nil
+}
+
+// ExecuteLinkRemoveCommand deletes links by ID
+func ExecuteLinkRemoveCommand(ctx context.Context, v *viper.Viper, opts LinkOptions) error {
+	service, err := newLinkService(v)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range opts.IDs {
+		if err := service.DeleteLink(ctx, id); err != nil {
+			cli.PrintError(err)
+			return err
+		}
+		fmt.Printf("✅ Link %s removed\n", id)
+	}
+	return nil
+}
+
+// NewLinkCommand creates the "link" command with add, list, remove, and types subcommands
+func NewLinkCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "link",
+		Short: "Add, list, and remove links between tickets",
+		Long: `Manage issue links. Link types are validated against the server, so renamed
+or custom types work; use "link types" to see what your instance provides.`,
+	}
+
+	addCmd := &cobra.Command{
+		Use:   "add KEY TYPE TARGET",
+		Short: "Link a ticket to another",
+		Long: `Link KEY to TARGET. TYPE is a link type name or either of its descriptions, e.g.:
+
+  link add PROJ-1 blocks PROJ-2
+  link add PROJ-1 "is blocked by" PROJ-2
+  link add PROJ-1 Relates PROJ-3`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := LinkOptions{Key: args[0], Type: args[1], Target: args[2]}
+			return ExecuteLinkAddCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list KEY",
+		Short: "List the links on a ticket",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := LinkOptions{Key: args[0]}
+			opts.Format, _ = cmd.Flags().GetString("format")
+			return ExecuteLinkListCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+	listCmd.Flags().String("format", "table", "Output format: table, json")
+
+	removeCmd := &cobra.Command{
+		Use:   "remove LINK_ID...",
+		Short: "Remove links by ID (see \"link list\")",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := LinkOptions{IDs: args}
+			return ExecuteLinkRemoveCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	typesCmd := &cobra.Command{
+		Use:   "types",
+		Short: "List the link types available on the server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return ExecuteLinkTypesCommand(cmd.Context(), viper.GetViper())
+		},
+	}
+
+	cmd.AddCommand(addCmd, listCmd, removeCmd, typesCmd)
+
+	return cmd
+}
@@ -0,0 +1,222 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// LogTimeOptions holds the options for the log-time command
+type LogTimeOptions struct {
+	Key       string
+	TimeSpent string
+	Started   string
+	Comment   string
+	List      bool
+	Update    string // Worklog ID to update
+	Delete    string // Worklog ID to delete
+}
+
+// ExecuteLogTimeCommand logs, lists, updates, or deletes time on a ticket
+func ExecuteLogTimeCommand(ctx context.Context, v *viper.Viper, opts LogTimeOptions) error {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	service := jira.NewWorklogService(client)
+
+	switch {
+	case opts.List:
+		return listWorklogs(ctx, service, opts.Key)
+
+	case opts.Delete != "":
+		if err := service.DeleteWorklog(ctx, opts.Key, opts.Delete); err != nil {
+			cli.PrintError(err)
+			return err
+		}
+		fmt.Printf("✅ Worklog %s deleted from %s\n", opts.Delete, opts.Key)
+		return nil
+
+	case opts.Update != "":
+		return updateWorklog(ctx, service, opts)
+	}
+
+	seconds, err := jira.ParseTimeSpent(opts.TimeSpent)
+	if err != nil {
+		return err
+	}
+	started, err := parseStarted(opts.Started, time.Now())
+	if err != nil {
+		return err
+	}
+
+	worklog, err := service.AddWorklog(ctx, opts.Key, started, seconds, opts.Comment)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Logged %s on %s (worklog %s, started %s)\n",
+		jira.FormatTimeSpent(seconds), opts.Key, worklog.ID, started.Format("2006-01-02 15:04"))
+	return nil
+}
+
+// updateWorklog changes a worklog, keeping its start time and duration unless new ones are given
+func updateWorklog(ctx context.Context, service *jira.WorklogService, opts LogTimeOptions) error {
+	existing, err := service.GetWorklog(ctx, opts.Key, opts.Update)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	seconds := existing.TimeSpentSeconds
+	if opts.TimeSpent != "" {
+		if seconds, err = jira.ParseTimeSpent(opts.TimeSpent); err != nil {
+			return err
+		}
+	}
+
+	var started time.Time
+	if opts.Started != "" {
+		started, err = parseStarted(opts.Started, time.Now())
+	} else {
+		started, err = existing.StartedTime()
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := service.UpdateWorklog(ctx, opts.Key, opts.Update, started, seconds, opts.Comment); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+	fmt.Printf("✅ Worklog %s on %s updated: %s\n", opts.Update, opts.Key, jira.FormatTimeSpent(seconds))
+	return nil
+}
+
+// listWorklogs prints the worklogs on a ticket
+func listWorklogs(ctx context.Context, service *jira.WorklogService, key string) error {
+	worklogs, err := service.GetWorklogs(ctx, key)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if len(worklogs) == 0 {
+		fmt.Printf("No time logged on %s\n", key)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tAUTHOR\tSTARTED\tTIME\tCOMMENT")
+	fmt.Fprintln(w, "--\t------\t-------\t----\t-------")
+
+	total := 0
+	for _, wl := range worklogs {
+		author := ""
+		if wl.Author != nil {
+			author = userDisplayName(wl.Author)
+		}
+		started := wl.Started
+		if t, err := wl.StartedTime(); err == nil {
+			started = t.Local().Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", wl.ID, author, started, jira.FormatTimeSpent(wl.TimeSpentSeconds), commentPreview(wl.Comment))
+		total += wl.TimeSpentSeconds
+	}
+	w.Flush()
+
+	fmt.Printf("\n📊 %d worklog(s), %s total\n", len(worklogs), jira.FormatTimeSpent(total))
+	return nil
+}
+
+// parseStarted parses a --started value, defaulting to now
+// Accepts RFC 3339, "YYYY-MM-DD HH:MM", "HH:MM" (today), or "YYYY-MM-DD" (09:00 that day)
+func parseStarted(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return now, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04", value, now.Location()); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("15:04", value, now.Location()); err == nil {
+		return time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location()), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t.Add(9 * time.Hour), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid start time: %s (use YYYY-MM-DD, YYYY-MM-DD HH:MM, HH:MM, or RFC 3339)", value)
+}
+
+// NewLogTimeCommand creates the "log-time" command
+func NewLogTimeCommand() *cobra.Command {
+	var opts LogTimeOptions
+
+	cmd := &cobra.Command{
+		Use:   "log-time KEY [DURATION]",
+		Short: "Log time spent on a ticket",
+		Long: `Log time spent on a JIRA ticket, e.g. "log-time PROJ-123 1h30m".
+
+Durations use JIRA notation: 1w 2d 3h 30m (a day is 8 hours, a week is 5 days).
+Use --list to show existing worklogs, and --update or --delete with a worklog ID to change them.
+An update keeps the worklog's duration and start time unless a new duration or --started is given.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Key = args[0]
+			if len(args) > 1 {
+				opts.TimeSpent = args[1]
+			}
+
+			// Bind flags to viper
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			// Read values from flags
+			opts.Started, _ = cmd.Flags().GetString("started")
+			opts.Comment, _ = cmd.Flags().GetString("comment")
+			opts.List, _ = cmd.Flags().GetBool("list")
+			opts.Update, _ = cmd.Flags().GetString("update")
+			opts.Delete, _ = cmd.Flags().GetString("delete")
+
+			if !opts.List && opts.Delete == "" && opts.Update == "" && opts.TimeSpent == "" {
+				return fmt.Errorf("duration is required (e.g., log-time %s 1h30m)", opts.Key)
+			}
+
+			return ExecuteLogTimeCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Started, "started", "", "When the work started: YYYY-MM-DD, YYYY-MM-DD HH:MM, or HH:MM today (default: now)")
+	cmd.Flags().StringVar(&opts.Comment, "comment", "", "Description of the work done (Markdown)")
+	cmd.Flags().BoolVar(&opts.List, "list", false, "List the ticket's worklogs")
+	cmd.Flags().StringVar(&opts.Update, "update", "", "Worklog ID to update with the given duration, start time and comment")
+	cmd.Flags().StringVar(&opts.Delete, "delete", "", "Worklog ID to delete")
+
+	return cmd
+}
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// MoveOptions holds the options for the move command
+type MoveOptions struct {
+	Key       string
+	ToProject string
+}
+
+// ExecuteMoveCommand executes the move command
+func ExecuteMoveCommand(ctx context.Context, v *viper.Viper, opts MoveOptions) error {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	issueService := jira.NewIssueService(client)
+
+	// Sub-tasks move with the ticket, so note their keys to rename their records
+	issue, err := issueService.GetIssueWithOptions(ctx, opts.Key, jira.GetOptions{Fields: []string{"subtasks"}})
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("🚚 Moving %s to %s...\n", opts.Key, opts.ToProject)
+	newKey, err := issueService.MoveIssue(ctx, opts.Key, opts.ToProject)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	renamed := map[string]string{opts.Key: newKey}
+	for _, subtask := range issue.Fields.Subtasks {
+		// The old key redirects to the moved sub-task
+		moved, err := issueService.GetIssueWithOptions(ctx, subtask.Key, jira.GetOptions{Fields: []string{"summary"}})
+		if err != nil {
+			fmt.Printf("⚠️  Warning: Failed to find moved sub-task %s: %v\n", subtask.Key, err)
+			continue
+		}
+		renamed[subtask.Key] = moved.Key
+	}
+	if err := renameTicketRecords(renamed); err != nil {
+		fmt.Printf("⚠️  Warning: Failed to update ticket records: %v\n", err)
+	}
+
+	fmt.Printf("✅ Moved %s -> %s\n", opts.Key, newKey)
+	fmt.Printf("🔗 View at: %s/browse/%s\n", cfg.JIRA.URL, newKey)
+	return nil
+}
+
+// renameTicketRecords renames the tracked records among old keys to their new keys
+func renameTicketRecords(renamed map[string]string) error {
+	repo, err := openTicketRepository()
+	if err != nil {
+		return err
+	}
+	for oldKey, newKey := range renamed {
+		if _, err := repo.GetByKey(oldKey); err != nil {
+			continue // Not tracked locally
+		}
+		if err := repo.Rename(oldKey, newKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewMoveCommand creates the "move" command
+func NewMoveCommand() *cobra.Command {
+	var opts MoveOptions
+
+	cmd := &cobra.Command{
+		Use:   "move KEY",
+		Short: "Move a ticket to another project",
+		Long: `Move a ticket and its sub-tasks to another project, keeping its issue type.
+Statuses and fields are mapped to the target project's defaults, and the ticket
+gets a new key; the old key keeps redirecting to it.
+
+Moving uses Jira Cloud's bulk move API. On Server and Data Center, use
+"clone --to-project" followed by "delete" instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Key = args[0]
+
+			// Bind flags to viper
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			return ExecuteMoveCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ToProject, "to-project", "", "Project to move the ticket to (REQUIRED)")
+	cmd.MarkFlagRequired("to-project")
+
+	return cmd
+}
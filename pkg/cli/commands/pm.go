@@ -1,14 +1,16 @@
 package commands
 
 import (
+	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/reports"
-	"github.com/clintonsteiner/jira-ticket-creator/internal/storage"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
 )
 
 // NewPMCommand creates the "pm" command for project management reporting
@@ -25,9 +27,11 @@ func NewPMCommand() *cobra.Command {
 		Short: "Executive summary dashboard",
 		Long:  "High-level overview of project status, team workload, and priorities",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePMDashboard()
+			where, _ := cmd.Flags().GetString("where")
+			return executePMDashboard(where)
 		},
 	}
+	addWhereFlag(dashboardCmd)
 
 	// Hierarchy subcommand
 	hierarchyCmd := &cobra.Command{
@@ -35,9 +39,11 @@ func NewPMCommand() *cobra.Command {
 		Short: "Show ticket hierarchy (parent-child relationships)",
 		Long:  "Display tickets organized by epics, stories, and their subtasks",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePMHierarchy()
+			where, _ := cmd.Flags().GetString("where")
+			return executePMHierarchy(where)
 		},
 	}
+	addWhereFlag(hierarchyCmd)
 
 	// Risk assessment subcommand
 	riskCmd := &cobra.Command{
@@ -45,9 +51,11 @@ func NewPMCommand() *cobra.Command {
 		Short: "Risk assessment and blockers",
 		Long:  "Identify blocked items, unassigned work, and project risks",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePMRisk()
+			where, _ := cmd.Flags().GetString("where")
+			return executePMRisk(where)
 		},
 	}
+	addWhereFlag(riskCmd)
 
 	// Details subcommand
 	detailsCmd := &cobra.Command{
@@ -55,19 +63,40 @@ func NewPMCommand() *cobra.Command {
 		Short: "Detailed ticket inventory",
 		Long:  "Complete table of all tickets with status, assignment, and dependencies",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePMDetails()
+			where, _ := cmd.Flags().GetString("where")
+			return executePMDetails(where)
 		},
 	}
+	addWhereFlag(detailsCmd)
 
-	// Parent ticket creation (will create a sample for now)
+	// Parent epic creation
+	var parentOpts PMCreateParentOptions
 	parentCmd := &cobra.Command{
 		Use:   "create-parent",
 		Short: "Create a parent epic ticket",
-		Long:  "Create a parent epic to organize and track related child tickets",
+		Long: `Create a parent epic to organize and track related child tickets.
+Existing tickets given with --children or matched by --jql are moved under the new epic.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePMCreateParent()
+			// Bind flags to viper
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			parentOpts.Summary, _ = cmd.Flags().GetString("summary")
+			parentOpts.Description, _ = cmd.Flags().GetString("description")
+			parentOpts.Priority, _ = cmd.Flags().GetString("priority")
+			parentOpts.Children, _ = cmd.Flags().GetStringSlice("children")
+			parentOpts.JQL, _ = cmd.Flags().GetString("jql")
+
+			return executePMCreateParent(cmd.Context(), viper.GetViper(), parentOpts)
 		},
 	}
+	parentCmd.Flags().StringVar(&parentOpts.Summary, "summary", "", "Epic summary (REQUIRED)")
+	parentCmd.Flags().StringVar(&parentOpts.Description, "description", "", "Epic description (Markdown)")
+	parentCmd.Flags().StringVar(&parentOpts.Priority, "priority", "", "Priority level (Lowest, Low, Medium, High, Highest)")
+	parentCmd.Flags().StringSliceVar(&parentOpts.Children, "children", []string{}, "Existing tickets to move under the epic (comma-separated)")
+	parentCmd.Flags().StringVar(&parentOpts.JQL, "jql", "", "JQL selecting existing tickets to move under the epic")
+	parentCmd.MarkFlagRequired("summary")
 
 	cmd.AddCommand(dashboardCmd, hierarchyCmd, riskCmd, detailsCmd, parentCmd)
 
@@ -75,23 +104,12 @@ func NewPMCommand() *cobra.Command {
 }
 
 // executePMDashboard shows the executive dashboard
-func executePMDashboard() error {
-	homeDir, err := os.UserHomeDir()
+func executePMDashboard(where string) error {
+	records, err := loadTrackedTickets(where)
 	if err != nil {
 		return err
 	}
 
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
-	}
-
-	records, err := repo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
-	}
-
 	pmReport := &reports.PMReport{}
 	dashboard := pmReport.GeneratePMDashboard(records)
 
@@ -100,23 +118,12 @@ func executePMDashboard() error {
 }
 
 // executePMHierarchy shows the ticket hierarchy
-func executePMHierarchy() error {
-	homeDir, err := os.UserHomeDir()
+func executePMHierarchy(where string) error {
+	records, err := loadTrackedTickets(where)
 	if err != nil {
 		return err
 	}
 
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
-	}
-
-	records, err := repo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
-	}
-
 	pmReport := &reports.PMReport{}
 	hierarchy := pmReport.GenerateProjectHierarchy(records)
 
@@ -125,75 +132,87 @@ func executePMHierarchy() error {
 }
 
 // executePMRisk shows risk assessment
-func executePMRisk() error {
-	homeDir, err := os.UserHomeDir()
+func executePMRisk(where string) error {
+	records, err := loadTrackedTickets(where)
 	if err != nil {
 		return err
 	}
 
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
-	}
+	pmReport := &reports.PMReport{}
+	risk := pmReport.GenerateRiskReport(records)
 
-	records, err := repo.GetAll()
+	fmt.Println(risk)
+	return nil
+}
+
+// executePMDetails shows detailed ticket inventory
+func executePMDetails(where string) error {
+	records, err := loadTrackedTickets(where)
 	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
+		return err
 	}
 
 	pmReport := &reports.PMReport{}
-	risk := pmReport.GenerateRiskReport(records)
+	details := pmReport.GenerateTicketDetailsTable(records)
 
-	fmt.Println(risk)
+	fmt.Println(details)
 	return nil
 }
 
-// executePMDetails shows detailed ticket inventory
-func executePMDetails() error {
-	homeDir, err := os.UserHomeDir()
+// PMCreateParentOptions holds the options for the pm create-parent command
+type PMCreateParentOptions struct {
+	Summary     string
+	Description string
+	Priority    string
+	Children    []string
+	JQL         string
+}
+
+// executePMCreateParent creates an epic and moves the selected tickets under it
+func executePMCreateParent(ctx context.Context, v *viper.Viper, opts PMCreateParentOptions) error {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
 		return err
 	}
 
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
+	client, err := newJiraClient(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
+		return err
+	}
+
+	fields := jira.IssueFields{
+		Project:     jira.Project{Key: cfg.JIRA.Project},
+		Summary:     opts.Summary,
+		Description: opts.Description,
+		IssueType:   jira.IssueType{Name: "Epic"},
+	}
+	if opts.Priority != "" {
+		fields.Priority = &jira.Priority{Name: opts.Priority}
 	}
 
-	records, err := repo.GetAll()
+	resp, err := jira.NewIssueService(client).CreateIssueWithFieldsContext(ctx, fields)
 	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
+		cli.PrintError(err)
+		return err
 	}
+	fmt.Printf("✅ Epic created: %s\n", resp.Key)
 
-	pmReport := &reports.PMReport{}
-	details := pmReport.GenerateTicketDetailsTable(records)
+	if err := saveTicketRecord(cfg.JIRA.Project, resp.Key, opts.Summary, ""); err != nil {
+		fmt.Printf("⚠️  Warning: Failed to save ticket record: %v\n", err)
+	}
 
-	fmt.Println(details)
-	return nil
-}
+	if len(opts.Children) == 0 && opts.JQL == "" {
+		fmt.Printf("\nAdd children with:\n  jira-ticket-creator create --summary \"...\" --parent %s\n", resp.Key)
+		fmt.Printf("  jira-ticket-creator epic add-children %s --jql '...'\n", resp.Key)
+		return nil
+	}
 
-// executePMCreateParent guides user through creating a parent epic
-func executePMCreateParent() error {
-	fmt.Println("\nCREATE PARENT EPIC")
-	fmt.Println("A parent epic is used to organize and track related work items.")
-	fmt.Println("\nTo create a parent epic:")
-	fmt.Println("  1. Use the standard create command with --type Epic")
-	fmt.Println("  2. Set it up to block child tickets")
-	fmt.Println("\nExample:")
-	fmt.Println("  ./jira-ticket-creator create \\")
-	fmt.Println("    --summary \"Q1 Platform Upgrade\" \\")
-	fmt.Println("    --type Epic \\")
-	fmt.Println("    --priority High \\")
-	fmt.Println("    --description \"Parent epic for all platform upgrade work\"")
-	fmt.Println("\nThen create child tickets with:")
-	fmt.Println("  ./jira-ticket-creator create \\")
-	fmt.Println("    --summary \"Upgrade Database\" \\")
-	fmt.Println("    --type Task \\")
-	fmt.Println("    --blocked-by <PARENT-EPIC-KEY>")
-	fmt.Println("\nView the hierarchy with:")
-	fmt.Println("  ./jira-ticket-creator pm hierarchy")
 	fmt.Println()
-	return nil
+	return addEpicChildren(ctx, client, EpicOptions{Epic: resp.Key, Keys: opts.Children, JQL: opts.JQL})
 }
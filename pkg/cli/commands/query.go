@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -23,10 +25,11 @@ type QueryOptions struct {
 	Output     string
 	MaxResults int
 	Fields     string
+	Filters    FilterOptions
 }
 
 // ExecuteQueryCommand executes the query command
-func ExecuteQueryCommand(v *viper.Viper, opts QueryOptions) error {
+func ExecuteQueryCommand(ctx context.Context, v *viper.Viper, opts QueryOptions) error {
 	// Load configuration with flag overrides
 	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
@@ -38,42 +41,39 @@ func ExecuteQueryCommand(v *viper.Viper, opts QueryOptions) error {
 		return err
 	}
 
-	if opts.JQL == "" {
-		return fmt.Errorf("--jql flag is required")
+	if opts.JQL == "" && opts.Filters.IsEmpty() {
+		return fmt.Errorf("--jql or a filter such as --status is required")
 	}
 
 	// Create JIRA client
-	client := jira.NewClient(cfg.JIRA.URL, cfg.JIRA.Email, cfg.JIRA.Token)
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
 	issueService := jira.NewIssueService(client)
 
-	var allIssues []jira.Issue
-	startAt := 0
-	fetchSize := 50
-	if opts.MaxResults < fetchSize {
-		fetchSize = opts.MaxResults
+	query, err := buildFilterQuery(ctx, client, opts.JQL, opts.Filters)
+	if err != nil {
+		return err
 	}
 
-	// Fetch all results with pagination
-	for {
-		issues, err := issueService.SearchIssues(opts.JQL, startAt, fetchSize)
-		if err != nil {
-			cli.PrintError(err)
-			return err
-		}
-
-		allIssues = append(allIssues, issues...)
-
-		if len(allIssues) >= opts.MaxResults || len(issues) < fetchSize {
-			break
-		}
-
-		startAt += fetchSize
+	// Fetch all results, one page at a time, requesting only the fields we display
+	searchOpts := jira.SearchOptions{MaxResults: opts.MaxResults}
+	if opts.Format != "json" || opts.Fields != "" {
+		searchOpts.Fields, searchOpts.Expand = serverFields(parseFields(opts.Fields))
 	}
 
-	// Trim to max results
-	if len(allIssues) > opts.MaxResults {
-		allIssues = allIssues[:opts.MaxResults]
+	var allIssues []jira.Issue
+	it := issueService.Search(ctx, query.String(), searchOpts)
+	for it.Next() {
+		allIssues = append(allIssues, it.Issue())
 	}
+	if err := it.Err(); err != nil {
+		err = fmt.Errorf("failed to search issues: %w", err)
+		cli.PrintError(err)
+		return err
+	}
+	names := it.Names()
 
 	// Format and output results
 	var output string
@@ -81,15 +81,15 @@ func ExecuteQueryCommand(v *viper.Viper, opts QueryOptions) error {
 	case "json":
 		output, err = formatJSON(allIssues)
 	case "csv":
-		output, err = formatCSV(allIssues, opts.Fields)
+		output, err = formatCSV(allIssues, opts.Fields, names)
 	case "markdown":
-		output, err = formatMarkdown(allIssues, opts.Fields)
+		output, err = formatMarkdown(allIssues, opts.Fields, names)
 	case "html":
-		output, err = formatHTML(allIssues, opts.Fields)
+		output, err = formatHTML(allIssues, opts.Fields, names)
 	case "table":
 		fallthrough
 	default:
-		output, err = formatTable(allIssues, opts.Fields)
+		output, err = formatTable(allIssues, opts.Fields, names)
 	}
 
 	if err != nil {
@@ -122,6 +122,87 @@ func parseFields(fieldsStr string) []string {
 	return fields
 }
 
+// columnFields maps query columns to the server-side fields they are read from
+var columnFields = map[string]string{
+	"key":         "", // Always returned
+	"type":        "issuetype",
+	"summary":     "summary",
+	"status":      "status",
+	"assignee":    "assignee",
+	"priority":    "priority",
+	"project":     "project",
+	"description": "description",
+}
+
+// serverFields translates query columns into the minimal fields parameter
+// Unknown columns are passed through as field IDs (e.g. customfield_10010), in
+// which case field names are expanded so headers can use display names
+func serverFields(columns []string) (fields []string, expand []string) {
+	seen := make(map[string]bool)
+	for _, column := range columns {
+		field, known := columnFields[column]
+		if !known {
+			field = column
+			expand = []string{"names"}
+		}
+		if field != "" && !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		// An empty fields parameter means all fields, so ask for the cheapest one
+		fields = []string{"summary"}
+	}
+	return fields, expand
+}
+
+// columnHeader returns the header for a column, using the field's display name when known
+func columnHeader(column string, names map[string]string) string {
+	if name, ok := names[column]; ok && name != "" {
+		return name
+	}
+	return column
+}
+
+// columnHeaders returns the headers for all columns
+func columnHeaders(columns []string, names map[string]string) []string {
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = columnHeader(column, names)
+	}
+	return headers
+}
+
+// formatCustomFieldValue renders a custom field value returned by the server
+func formatCustomFieldValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, formatCustomFieldValue(item))
+		}
+		return strings.Join(parts, ", ")
+	case map[string]interface{}:
+		// Option, user and version values carry a display-friendly key
+		for _, key := range []string{"value", "displayName", "name", "key"} {
+			if s, ok := v[key].(string); ok {
+				return s
+			}
+		}
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
 // getFieldValue retrieves a field value from an issue
 func getFieldValue(issue jira.Issue, field string) string {
 	switch field {
@@ -136,7 +217,10 @@ func getFieldValue(issue jira.Issue, field string) string {
 		}
 		return summary
 	case "status":
-		return issue.Fields.IssueType.Name // Placeholder, status not in Issue struct
+		if issue.Fields.Status != nil {
+			return issue.Fields.Status.Name
+		}
+		return ""
 	case "assignee":
 		if issue.Fields.Assignee != nil {
 			return issue.Fields.Assignee.Name
@@ -156,19 +240,20 @@ func getFieldValue(issue jira.Issue, field string) string {
 		}
 		return desc
 	default:
-		return ""
+		return formatCustomFieldValue(issue.Fields.CustomFields[field])
 	}
 }
 
 // formatTable formats issues as a table
-func formatTable(issues []jira.Issue, fieldsStr string) (string, error) {
+func formatTable(issues []jira.Issue, fieldsStr string, names map[string]string) (string, error) {
 	fields := parseFields(fieldsStr)
+	headers := columnHeaders(fields, names)
 
 	var sb strings.Builder
 	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
 
 	// Write header
-	header := strings.Join(fields, "\t")
+	header := strings.Join(headers, "\t")
 	fmt.Fprintln(w, strings.ToUpper(header))
 	fmt.Fprintln(w, strings.Repeat("-\t", len(fields)))
 
@@ -195,14 +280,15 @@ func formatJSON(issues []jira.Issue) (string, error) {
 }
 
 // formatCSV formats issues as CSV
-func formatCSV(issues []jira.Issue, fieldsStr string) (string, error) {
+func formatCSV(issues []jira.Issue, fieldsStr string, names map[string]string) (string, error) {
 	fields := parseFields(fieldsStr)
+	headers := columnHeaders(fields, names)
 
 	var sb strings.Builder
 	w := csv.NewWriter(&sb)
 
 	// Write header
-	w.Write(fields)
+	w.Write(headers)
 
 	// Write rows
 	for _, issue := range issues {
@@ -222,11 +308,12 @@ func formatCSV(issues []jira.Issue, fieldsStr string) (string, error) {
 }
 
 // formatMarkdown formats issues as Markdown
-func formatMarkdown(issues []jira.Issue, fieldsStr string) (string, error) {
+func formatMarkdown(issues []jira.Issue, fieldsStr string, names map[string]string) (string, error) {
 	fields := parseFields(fieldsStr)
+	headers := columnHeaders(fields, names)
 
 	var sb strings.Builder
-	sb.WriteString("| " + strings.Join(fields, " | ") + " |\n")
+	sb.WriteString("| " + strings.Join(headers, " | ") + " |\n")
 	sb.WriteString("|" + strings.Repeat(" --- |", len(fields)) + "\n")
 
 	for _, issue := range issues {
@@ -241,14 +328,15 @@ func formatMarkdown(issues []jira.Issue, fieldsStr string) (string, error) {
 }
 
 // formatHTML formats issues as HTML
-func formatHTML(issues []jira.Issue, fieldsStr string) (string, error) {
+func formatHTML(issues []jira.Issue, fieldsStr string, names map[string]string) (string, error) {
 	fields := parseFields(fieldsStr)
+	headers := columnHeaders(fields, names)
 
 	var sb strings.Builder
 	sb.WriteString("<table>\n<thead>\n<tr>\n")
 
-	for _, field := range fields {
-		sb.WriteString("<th>" + strings.ToUpper(field) + "</th>\n")
+	for _, header := range headers {
+		sb.WriteString("<th>" + strings.ToUpper(header) + "</th>\n")
 	}
 
 	sb.WriteString("</tr>\n</thead>\n<tbody>\n")
@@ -272,7 +360,10 @@ func NewQueryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "query",
 		Short: "Execute JQL queries and display results",
-		Long:  "Execute JQL (JIRA Query Language) queries and display results in multiple formats (table, json, csv, markdown, html).",
+		Long: `Execute JQL (JIRA Query Language) queries and display results in multiple formats (table, json, csv, markdown, html).
+
+The filters --project, --status, --assignee, --type, --label and --updated-since
+are compiled to JQL with their values quoted, and combined with --jql when given.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Bind flags to viper
 			if err := viper.BindPFlags(cmd.Flags()); err != nil {
@@ -285,16 +376,18 @@ func NewQueryCommand() *cobra.Command {
 			opts.Output, _ = cmd.Flags().GetString("output")
 			opts.MaxResults, _ = cmd.Flags().GetInt("max-results")
 			opts.Fields, _ = cmd.Flags().GetString("fields")
+			readFilterFlags(cmd, &opts.Filters)
 
-			return ExecuteQueryCommand(viper.GetViper(), opts)
+			return ExecuteQueryCommand(cmd.Context(), viper.GetViper(), opts)
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.JQL, "jql", "", "JQL query string (REQUIRED). Example: 'project = PROJ AND status = \"To Do\"'")
+	cmd.Flags().StringVar(&opts.JQL, "jql", "", "JQL query string, combined with any filter flags. Example: 'project = PROJ AND status = \"To Do\"'")
 	cmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json, csv, markdown, html (default: table)")
 	cmd.Flags().StringVar(&opts.Output, "output", "", "Output file path (optional, default: print to stdout)")
-	cmd.Flags().IntVar(&opts.MaxResults, "max-results", 50, "Maximum number of results to fetch (max: 1000, default: 50)")
-	cmd.Flags().StringVar(&opts.Fields, "fields", "", "Comma-separated fields to display. Available: key, type, summary, status, assignee, priority, project, description (default: key,type,summary,status,assignee,priority)")
+	cmd.Flags().IntVar(&opts.MaxResults, "max-results", 50, "Maximum number of results to fetch (0 = all)")
+	addFilterFlags(cmd, &opts.Filters)
+	cmd.Flags().StringVar(&opts.Fields, "fields", "", "Comma-separated fields to display. Available: key, type, summary, status, assignee, priority, project, description, or a field ID such as customfield_10010 (default: key,type,summary,status,assignee,priority)")
 
 	cmd.MarkFlagRequired("jql")
 
@@ -0,0 +1,323 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/reports"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// ReleaseOptions holds the options for the release subcommands
+type ReleaseOptions struct {
+	Version     string // Version ID or name
+	Description string
+	StartDate   string
+	ReleaseDate string
+	All         bool
+	Format      string
+	Output      string
+}
+
+// fixVersionFlagUsage is the help text for --fix-version flags
+const fixVersionFlagUsage = "Fix version(s) by name, e.g. --fix-version 2.4.0 (comma-separated or repeatable)"
+
+// affectsVersionFlagUsage is the help text for --affects-version flags
+const affectsVersionFlagUsage = "Affected version(s) by name (comma-separated or repeatable)"
+
+// newReleaseClient loads configuration and creates a JIRA client
+func newReleaseClient(v *viper.Viper) (*jira.Client, *config.Config, error) {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return nil, nil, err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, cfg, nil
+}
+
+// parseVersionDate validates a YYYY-MM-DD version date; empty is allowed
+func parseVersionDate(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(jira.DateFormat, value); err != nil {
+		return "", fmt.Errorf("invalid date: %s (use YYYY-MM-DD)", value)
+	}
+	return value, nil
+}
+
+// versionStatus describes a version as released, archived or unreleased
+func versionStatus(v jira.Version) string {
+	switch {
+	case v.Archived:
+		return "archived"
+	case v.Released:
+		return "released"
+	case v.Overdue:
+		return "overdue"
+	default:
+		return "unreleased"
+	}
+}
+
+// ExecuteReleaseListCommand lists the project's versions
+func ExecuteReleaseListCommand(ctx context.Context, v *viper.Viper, opts ReleaseOptions) error {
+	client, cfg, err := newReleaseClient(v)
+	if err != nil {
+		return err
+	}
+
+	versions, err := jira.NewVersionService(client).GetVersions(ctx, cfg.JIRA.Project)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	// Unless --all, show only versions still to be released
+	var shown []jira.Version
+	for _, version := range versions {
+		if opts.All || (!version.Released && !version.Archived) {
+			shown = append(shown, version)
+		}
+	}
+
+	if opts.Format == "json" {
+		data, err := json.MarshalIndent(shown, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(shown) == 0 {
+		fmt.Printf("No versions in %s\n", cfg.JIRA.Project)
+		return nil
+	}
+
+	fmt.Printf("📋 Versions in %s\n\n", cfg.JIRA.Project)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSTATUS\tSTART\tRELEASE\tDESCRIPTION")
+	fmt.Fprintln(w, "--\t----\t------\t-----\t-------\t-----------")
+	for _, version := range shown {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", version.ID, version.Name, versionStatus(version),
+			sprintDay(version.StartDate), sprintDay(version.ReleaseDate), commentPreview(version.Description))
+	}
+	w.Flush()
+	return nil
+}
+
+// ExecuteReleaseCreateCommand creates a version in the project
+func ExecuteReleaseCreateCommand(ctx context.Context, v *viper.Viper, opts ReleaseOptions) error {
+	startDate, err := parseVersionDate(opts.StartDate)
+	if err != nil {
+		return err
+	}
+	releaseDate, err := parseVersionDate(opts.ReleaseDate)
+	if err != nil {
+		return err
+	}
+
+	client, cfg, err := newReleaseClient(v)
+	if err != nil {
+		return err
+	}
+
+	version, err := jira.NewVersionService(client).CreateVersion(ctx, jira.Version{
+		Name:        opts.Version,
+		Description: opts.Description,
+		Project:     cfg.JIRA.Project,
+		StartDate:   startDate,
+		ReleaseDate: releaseDate,
+	})
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Version %s created in %s (ID %s)\n", version.Name, cfg.JIRA.Project, version.ID)
+	return nil
+}
+
+// ExecuteReleaseReleaseCommand marks a version released
+func ExecuteReleaseReleaseCommand(ctx context.Context, v *viper.Viper, opts ReleaseOptions) error {
+	date := time.Now()
+	if opts.ReleaseDate != "" {
+		parsed, err := time.Parse(jira.DateFormat, opts.ReleaseDate)
+		if err != nil {
+			return fmt.Errorf("invalid date: %s (use YYYY-MM-DD)", opts.ReleaseDate)
+		}
+		date = parsed
+	}
+
+	client, cfg, err := newReleaseClient(v)
+	if err != nil {
+		return err
+	}
+
+	service := jira.NewVersionService(client)
+	version, err := service.FindVersion(ctx, cfg.JIRA.Project, opts.Version)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+	if version.Released {
+		fmt.Printf("⚠️  Version %s is already released\n", version.Name)
+		return nil
+	}
+
+	// Warn about work that is still open in the version
+	open := 0
+	query := jql.New().Where(
+		jql.Eq("project", jql.String(cfg.JIRA.Project)),
+		jql.Eq("fixVersion", jql.String(version.Name)),
+		jql.IsEmpty("resolution"),
+	)
+	err = jira.NewIssueService(client).SearchAll(ctx, query.String(), jira.SearchOptions{Fields: []string{"summary"}}, func(jira.Issue) error {
+		open++
+		return nil
+	})
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+	if open > 0 {
+		fmt.Printf("⚠️  %d unresolved ticket(s) remain in %s\n", open, version.Name)
+	}
+
+	if _, err := service.ReleaseVersion(ctx, version.ID, date); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Version %s released on %s\n", version.Name, date.Format(jira.DateFormat))
+	return nil
+}
+
+// ExecuteReleaseNotesCommand writes release notes for a version
+func ExecuteReleaseNotesCommand(ctx context.Context, v *viper.Viper, opts ReleaseOptions) error {
+	client, cfg, err := newReleaseClient(v)
+	if err != nil {
+		return err
+	}
+
+	version, err := jira.NewVersionService(client).FindVersion(ctx, cfg.JIRA.Project, opts.Version)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	var issues []jira.Issue
+	searchOpts := jira.SearchOptions{Fields: []string{"summary", "issuetype", "status"}}
+	err = jira.NewIssueService(client).SearchAll(ctx, jira.FixVersionJQL(cfg.JIRA.Project, version.Name), searchOpts, func(issue jira.Issue) error {
+		issues = append(issues, issue)
+		return nil
+	})
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	notes := reports.NewReleaseNotes(*version, cfg.JIRA.URL, issues)
+	output, err := notes.Generate(opts.Format)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output != "" {
+		if err := os.WriteFile(opts.Output, []byte(output), 0644); err != nil {
+			return fmt.Errorf("failed to write release notes: %w", err)
+		}
+		fmt.Printf("✅ Release notes written to: %s (%d tickets)\n", opts.Output, len(issues))
+		return nil
+	}
+
+	fmt.Print(output)
+	return nil
+}
+
+// NewReleaseCommand creates the "release" command group
+func NewReleaseCommand() *cobra.Command {
+	var opts ReleaseOptions
+
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "Manage project versions and release notes",
+		Long: `List, create, and release the project's versions, and generate release notes
+from the tickets whose fix version is set. Versions are given by name or ID.`,
+	}
+
+	// run wraps an Execute function with flag binding
+	run := func(execute func(context.Context, *viper.Viper, ReleaseOptions) error) func(*cobra.Command, []string) error {
+		return func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Version = args[0]
+			}
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			return execute(cmd.Context(), viper.GetViper(), opts)
+		}
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List unreleased versions",
+		Args:  cobra.NoArgs,
+		RunE:  run(ExecuteReleaseListCommand),
+	}
+	listCmd.Flags().BoolVar(&opts.All, "all", false, "Include released and archived versions")
+	listCmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json")
+
+	createCmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a version",
+		Args:  cobra.ExactArgs(1),
+		RunE:  run(ExecuteReleaseCreateCommand),
+	}
+	createCmd.Flags().StringVar(&opts.Description, "description", "", "Version description")
+	createCmd.Flags().StringVar(&opts.StartDate, "start", "", "Start date (YYYY-MM-DD)")
+	createCmd.Flags().StringVar(&opts.ReleaseDate, "release-date", "", "Planned release date (YYYY-MM-DD)")
+
+	releaseCmd := &cobra.Command{
+		Use:   "release VERSION",
+		Short: "Mark a version released",
+		Args:  cobra.ExactArgs(1),
+		RunE:  run(ExecuteReleaseReleaseCommand),
+	}
+	releaseCmd.Flags().StringVar(&opts.ReleaseDate, "date", "", "Release date (YYYY-MM-DD, default: today)")
+
+	notesCmd := &cobra.Command{
+		Use:   "notes VERSION",
+		Short: "Generate release notes for a version",
+		Long:  "Generate release notes listing the version's tickets grouped by issue type, each linked back to JIRA.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  run(ExecuteReleaseNotesCommand),
+	}
+	notesCmd.Flags().StringVar(&opts.Format, "format", "markdown", "Output format: markdown, html")
+	notesCmd.Flags().StringVar(&opts.Output, "output", "", "Output file path (optional, default: print to stdout)")
+
+	cmd.AddCommand(listCmd, createCmd, releaseCmd, notesCmd)
+
+	return cmd
+}
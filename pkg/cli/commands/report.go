@@ -1,15 +1,19 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/reports"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/storage"
 	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
@@ -22,7 +26,7 @@ type ReportOptions struct {
 }
 
 // ExecuteReportCommand executes the report command
-func ExecuteReportCommand(v *viper.Viper, opts ReportOptions) error {
+func ExecuteReportCommand(ctx context.Context, v *viper.Viper, opts ReportOptions) error {
 	// Load configuration
 	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
@@ -57,16 +61,35 @@ func ExecuteReportCommand(v *viper.Viper, opts ReportOptions) error {
 	}
 
 	// Get ticket details from JIRA
-	client := jira.NewClient(cfg.JIRA.URL, cfg.JIRA.Email, cfg.JIRA.Token)
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
 	issueService := jira.NewIssueService(client)
+	commentService := jira.NewCommentService(client)
 
 	var issues []jira.Issue
 	for _, record := range records {
-		issue, err := issueService.GetIssue(record.Key)
+		issue, err := issueService.GetIssueContext(ctx, record.Key)
 		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
 			fmt.Printf("⚠️  Failed to fetch details for %s: %v\n", record.Key, err)
 			continue
 		}
+
+		// The issue only embeds the most recent comments, so fetch the rest for exports that include them
+		if (opts.Format == "json" || opts.Format == "html") && issue.Fields.Comment != nil &&
+			issue.Fields.Comment.Total > len(issue.Fields.Comment.Comments) {
+			comments, err := commentService.GetComments(ctx, record.Key)
+			if err != nil {
+				fmt.Printf("⚠️  Failed to fetch comments for %s: %v\n", record.Key, err)
+			} else {
+				issue.Fields.Comment.Comments = comments
+			}
+		}
+
 		issues = append(issues, *issue)
 	}
 
@@ -110,12 +133,199 @@ func NewReportCommand() *cobra.Command {
 			opts.Format, _ = cmd.Flags().GetString("format")
 			opts.Output, _ = cmd.Flags().GetString("output")
 
-			return ExecuteReportCommand(viper.GetViper(), opts)
+			return ExecuteReportCommand(cmd.Context(), viper.GetViper(), opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json, csv, markdown, html (default: table)")
 	cmd.Flags().StringVar(&opts.Output, "output", "", "Output file path (optional, default: print to stdout)")
 
+	cmd.AddCommand(newTimesheetCommand())
+
+	return cmd
+}
+
+// TimesheetOptions holds options for the report timesheet command
+type TimesheetOptions struct {
+	JQL    string
+	From   string
+	To     string
+	User   string
+	Format string
+	Output string
+}
+
+// ExecuteTimesheetCommand aggregates worklogs for a JQL scope into a timesheet
+func ExecuteTimesheetCommand(ctx context.Context, v *viper.Viper, opts TimesheetOptions) error {
+	// Load configuration
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return err
+	}
+
+	from, to, err := timesheetRange(opts.From, opts.To, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// Default scope: every ticket in the project with time logged in the range
+	query := opts.JQL
+	if query == "" {
+		query = jql.New().Where(
+			jql.Eq("project", jql.String(cfg.JIRA.Project)),
+			jql.Gte("worklogDate", jql.Date(from)),
+			jql.Lte("worklogDate", jql.Date(to)),
+		).String()
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	issueService := jira.NewIssueService(client)
+	worklogService := jira.NewWorklogService(client)
+
+	// End of the last day, so worklogs started on --to are included
+	end := to.AddDate(0, 0, 1)
+
+	var entries []reports.TimesheetEntry
+	err = issueService.SearchAll(ctx, query, jira.SearchOptions{Fields: []string{"summary"}}, func(issue jira.Issue) error {
+		worklogs, err := worklogService.GetWorklogs(ctx, issue.Key)
+		if err != nil {
+			return err
+		}
+
+		for _, wl := range worklogs {
+			started, err := wl.StartedTime()
+			if err != nil {
+				return err
+			}
+			started = started.Local()
+			if started.Before(from) || !started.Before(end) {
+				continue
+			}
+
+			user := "Unknown"
+			if wl.Author != nil {
+				user = userDisplayName(wl.Author)
+				if opts.User != "" && !userMatches(wl.Author, opts.User) {
+					continue
+				}
+			} else if opts.User != "" {
+				continue
+			}
+
+			entries = append(entries, reports.TimesheetEntry{
+				User:    user,
+				Day:     started,
+				Key:     issue.Key,
+				Summary: issue.Fields.Summary,
+				Seconds: wl.TimeSpentSeconds,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	timesheet := reports.NewTimesheetReport(entries, from, to)
+	report, err := timesheet.Generate(opts.Format)
+	if err != nil {
+		cli.PrintError(fmt.Errorf("failed to generate report: %w", err))
+		return err
+	}
+
+	// Output report
+	if opts.Output != "" {
+		if err := os.WriteFile(opts.Output, []byte(report), 0644); err != nil {
+			cli.PrintError(fmt.Errorf("failed to write report: %w", err))
+			return err
+		}
+		fmt.Printf("✅ Timesheet written to: %s\n", opts.Output)
+	} else {
+		fmt.Println(report)
+	}
+
+	return nil
+}
+
+// timesheetRange parses --from/--to dates, defaulting to Monday of the current week through today
+func timesheetRange(fromStr, toStr string, now time.Time) (time.Time, time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	from := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	if fromStr != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", fromStr, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --from date: %s (use YYYY-MM-DD)", fromStr)
+		}
+		from = parsed
+	}
+
+	to := today
+	if toStr != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", toStr, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --to date: %s (use YYYY-MM-DD)", toStr)
+		}
+		to = parsed
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("--to (%s) is before --from (%s)", to.Format("2006-01-02"), from.Format("2006-01-02"))
+	}
+	return from, to, nil
+}
+
+// userMatches reports whether u is identified by any of its names, email, or account ID
+func userMatches(u *jira.User, user string) bool {
+	for _, id := range []string{u.DisplayName, u.Name, u.EmailAddress, u.AccountID} {
+		if id != "" && strings.EqualFold(id, user) {
+			return true
+		}
+	}
+	return false
+}
+
+// newTimesheetCommand creates the "report timesheet" command
+func newTimesheetCommand() *cobra.Command {
+	var opts TimesheetOptions
+
+	cmd := &cobra.Command{
+		Use:   "timesheet",
+		Short: "Report logged time per user, day and ticket",
+		Long:  "Aggregate worklogs for the tickets matching a JQL query into a timesheet (table, csv, html).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Bind flags to viper
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			// Read values from flags
+			opts.JQL, _ = cmd.Flags().GetString("jql")
+			opts.From, _ = cmd.Flags().GetString("from")
+			opts.To, _ = cmd.Flags().GetString("to")
+			opts.User, _ = cmd.Flags().GetString("user")
+			opts.Format, _ = cmd.Flags().GetString("format")
+			opts.Output, _ = cmd.Flags().GetString("output")
+
+			return ExecuteTimesheetCommand(cmd.Context(), viper.GetViper(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.JQL, "jql", "", "Tickets to include (default: tickets in the project with time logged in the date range)")
+	cmd.Flags().StringVar(&opts.From, "from", "", "First day to include, YYYY-MM-DD (default: Monday of this week)")
+	cmd.Flags().StringVar(&opts.To, "to", "", "Last day to include, YYYY-MM-DD (default: today)")
+	cmd.Flags().StringVar(&opts.User, "user", "", "Only include time logged by this user (display name, username, email, or account ID)")
+	cmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, csv, html (default: table)")
+	cmd.Flags().StringVar(&opts.Output, "output", "", "Output file path (optional, default: print to stdout)")
+
 	return cmd
 }
@@ -28,6 +28,8 @@ It supports creating, updating, searching, and reporting on tickets with feature
 	cmd.PersistentFlags().String("token", "", "JIRA API token for authentication. Can also set JIRA_TOKEN env var")
 	cmd.PersistentFlags().String("project", "", "JIRA project key (e.g., PROJ). Can also set JIRA_PROJECT env var")
 	cmd.PersistentFlags().String("ticket", "", "JIRA ticket key to extract project (e.g., PROJ-123). Can also set JIRA_TICKET env var")
+	cmd.PersistentFlags().String("auth-type", "", "Authentication type: basic (email + API token), bearer (personal access token), oauth2, or cookie. Can also set JIRA_AUTH_TYPE env var")
+	cmd.PersistentFlags().Int("api-version", 0, "JIRA REST API version: 2 (default) or 3 for Jira Cloud rich text (ADF). Can also set JIRA_API_VERSION env var")
 	cmd.PersistentFlags().String("config", "", "Path to configuration file (default: ~/.jirarc in YAML format)")
 
 	// Bind to viper
@@ -36,12 +38,24 @@ It supports creating, updating, searching, and reporting on tickets with feature
 	viper.BindPFlag("jira.token", cmd.PersistentFlags().Lookup("token"))
 	viper.BindPFlag("jira.project", cmd.PersistentFlags().Lookup("project"))
 	viper.BindPFlag("jira.ticket", cmd.PersistentFlags().Lookup("ticket"))
+	viper.BindPFlag("auth.type", cmd.PersistentFlags().Lookup("auth-type"))
+	viper.BindPFlag("api.version", cmd.PersistentFlags().Lookup("api-version"))
 
 	// Add subcommands
 	cmd.AddCommand(NewCreateCommand())
 	cmd.AddCommand(NewReportCommand())
 	cmd.AddCommand(NewUpdateCommand())
 	cmd.AddCommand(NewTransitionCommand())
+	cmd.AddCommand(NewCloneCommand())
+	cmd.AddCommand(NewMoveCommand())
+	cmd.AddCommand(NewDeleteCommand())
+	cmd.AddCommand(NewCommentCommand())
+	cmd.AddCommand(NewAttachCommand())
+	cmd.AddCommand(NewLogTimeCommand())
+	cmd.AddCommand(NewLinkCommand())
+	cmd.AddCommand(NewEpicCommand())
+	cmd.AddCommand(NewSprintCommand())
+	cmd.AddCommand(NewReleaseCommand())
 	cmd.AddCommand(NewSearchCommand())
 	cmd.AddCommand(NewQueryCommand())
 	cmd.AddCommand(NewImportCommand())
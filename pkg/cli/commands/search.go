@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -11,19 +12,22 @@ import (
 
 	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
 	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
 )
 
 // SearchOptions holds the options for the search command
 type SearchOptions struct {
-	Key     string
-	Summary string
-	JQL     string
-	Format  string
+	Key        string
+	Summary    string
+	JQL        string
+	Format     string
+	MaxResults int
+	Filters    FilterOptions
 }
 
 // ExecuteSearchCommand executes the search command
-func ExecuteSearchCommand(v *viper.Viper, opts SearchOptions) error {
+func ExecuteSearchCommand(ctx context.Context, v *viper.Viper, opts SearchOptions) error {
 	// Load configuration with flag overrides
 	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
@@ -36,39 +40,49 @@ func ExecuteSearchCommand(v *viper.Viper, opts SearchOptions) error {
 	}
 
 	// Create JIRA client
-	client := jira.NewClient(cfg.JIRA.URL, cfg.JIRA.Email, cfg.JIRA.Token)
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
 	issueService := jira.NewIssueService(client)
 
 	var issues []jira.Issue
 
+	// The table only shows a few columns, so skip fetching the rest
+	var fields []string
+	if opts.Format != "json" {
+		fields = tableFields
+	}
+
 	// Perform search based on provided options
 	if opts.Key != "" {
 		// Search by key
-		issue, err := issueService.GetIssue(opts.Key)
+		issue, err := issueService.GetIssueWithOptions(ctx, opts.Key, jira.GetOptions{Fields: fields})
 		if err != nil {
 			cli.PrintError(err)
 			return err
 		}
 		issues = []jira.Issue{*issue}
-	} else if opts.Summary != "" {
-		// Search by summary using JQL
-		jql := fmt.Sprintf("text ~ \"%s\"", opts.Summary)
-		found, err := issueService.SearchIssues(jql, 0, 50)
+	} else if opts.Summary != "" || opts.JQL != "" || !opts.Filters.IsEmpty() {
+		query, err := buildFilterQuery(ctx, client, opts.JQL, opts.Filters)
 		if err != nil {
-			cli.PrintError(err)
 			return err
 		}
-		issues = found
-	} else if opts.JQL != "" {
-		// Search using raw JQL
-		found, err := issueService.SearchIssues(opts.JQL, 0, 50)
+		if opts.Summary != "" {
+			// Search by summary text
+			query.Where(jql.Contains("text", opts.Summary))
+		}
+
+		err = issueService.SearchAll(ctx, query.String(), jira.SearchOptions{Fields: fields, MaxResults: opts.MaxResults}, func(issue jira.Issue) error {
+			issues = append(issues, issue)
+			return nil
+		})
 		if err != nil {
 			cli.PrintError(err)
 			return err
 		}
-		issues = found
 	} else {
-		return fmt.Errorf("must provide one of: --key, --summary, or --jql")
+		return fmt.Errorf("must provide one of: --key, --summary, --jql, or a filter such as --status")
 	}
 
 	// Format and output results
@@ -83,6 +97,9 @@ func ExecuteSearchCommand(v *viper.Viper, opts SearchOptions) error {
 	return nil
 }
 
+// tableFields are the server-side fields shown by outputTable
+var tableFields = []string{"issuetype", "summary", "status"}
+
 // outputTable outputs search results as a table
 func outputTable(issues []jira.Issue) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
@@ -122,7 +139,10 @@ func NewSearchCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "search",
 		Short: "Search for JIRA tickets",
-		Long:  "Search for JIRA tickets by key, summary, or JQL query.",
+		Long: `Search for JIRA tickets by key, summary, JQL query, or filters.
+
+The filters --project, --status, --assignee, --type, --label and --updated-since
+are combined with each other, --summary and --jql; all must match.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Bind flags to viper
 			if err := viper.BindPFlags(cmd.Flags()); err != nil {
@@ -134,8 +154,10 @@ func NewSearchCommand() *cobra.Command {
 			opts.Summary, _ = cmd.Flags().GetString("summary")
 			opts.JQL, _ = cmd.Flags().GetString("jql")
 			opts.Format, _ = cmd.Flags().GetString("format")
+			opts.MaxResults, _ = cmd.Flags().GetInt("max-results")
+			readFilterFlags(cmd, &opts.Filters)
 
-			return ExecuteSearchCommand(viper.GetViper(), opts)
+			return ExecuteSearchCommand(cmd.Context(), viper.GetViper(), opts)
 		},
 	}
 
@@ -143,6 +165,8 @@ func NewSearchCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.Summary, "summary", "", "Search by summary text (partial match supported)")
 	cmd.Flags().StringVar(&opts.JQL, "jql", "", "Advanced search using JQL (JIRA Query Language)")
 	cmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json")
+	cmd.Flags().IntVar(&opts.MaxResults, "max-results", 50, "Maximum number of results (0 = all)")
+	addFilterFlags(cmd, &opts.Filters)
 
 	return cmd
 }
@@ -0,0 +1,449 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
+)
+
+// SprintOptions holds the options for the sprint subcommands
+type SprintOptions struct {
+	Board  string // Board ID or name; defaults to the project's only Scrum board
+	Sprint string // Sprint ID, name, "active" or "next"
+	Name   string
+	Goal   string
+	Start  string
+	End    string
+	Weeks  int
+	States []string
+	Keys   []string
+	Format string
+}
+
+// sprintFlagUsage is the help text for --sprint flags on create commands
+const sprintFlagUsage = "Add the new ticket(s) to a sprint: ID, name, active, or next"
+
+// boardFlagUsage is the help text for --board flags on create commands
+const boardFlagUsage = "Board ID or name used to find --sprint by name (default: the project's Scrum board)"
+
+// newAgileService loads configuration and creates an agile service
+func newAgileService(v *viper.Viper) (*jira.AgileService, *config.Config, error) {
+	// Load configuration with flag overrides
+	cfg, err := config.LoadConfigWithFlags(v)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Validate required configuration
+	if err := cfg.ValidateRequired(); err != nil {
+		return nil, nil, err
+	}
+
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return jira.NewAgileService(client), cfg, nil
+}
+
+// resolveSprint finds a sprint by ID, or by name or keyword on the selected board
+func resolveSprint(ctx context.Context, service *jira.AgileService, board, sprint, projectKey string) (*jira.Sprint, error) {
+	if _, err := strconv.Atoi(sprint); err == nil {
+		return service.FindSprint(ctx, 0, sprint)
+	}
+
+	b, err := service.ResolveBoard(ctx, board, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	return service.FindSprint(ctx, b.ID, sprint)
+}
+
+// parseSprintDate parses a YYYY-MM-DD or "YYYY-MM-DD HH:MM" sprint boundary, defaulting to fallback
+func parseSprintDate(value string, fallback time.Time) (time.Time, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", jira.DateFormat, time.RFC3339} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date: %s (use YYYY-MM-DD or YYYY-MM-DD HH:MM)", value)
+}
+
+// sprintRange returns a sprint's start and end from --start, --end and --weeks
+func sprintRange(opts SprintOptions, now time.Time) (time.Time, time.Time, error) {
+	start, err := parseSprintDate(opts.Start, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := parseSprintDate(opts.End, start.AddDate(0, 0, 7*opts.Weeks))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("sprint end %s is not after its start %s", end.Format(jira.DateFormat), start.Format(jira.DateFormat))
+	}
+	return start, end, nil
+}
+
+// sprintDay formats a sprint timestamp as YYYY-MM-DD, or "-" when unset
+func sprintDay(value string) string {
+	if len(value) < len(jira.DateFormat) {
+		return "-"
+	}
+	return value[:len(jira.DateFormat)]
+}
+
+// ExecuteSprintListCommand lists a board's sprints
+func ExecuteSprintListCommand(ctx context.Context, v *viper.Viper, opts SprintOptions) error {
+	service, cfg, err := newAgileService(v)
+	if err != nil {
+		return err
+	}
+
+	board, err := service.ResolveBoard(ctx, opts.Board, cfg.JIRA.Project)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	sprints, err := service.GetSprints(ctx, board.ID, opts.States...)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if opts.Format == "json" {
+		data, err := json.MarshalIndent(sprints, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(sprints) == 0 {
+		fmt.Printf("No sprints on board %s\n", board.Name)
+		return nil
+	}
+
+	fmt.Printf("🏃 Sprints on %s (board %d)\n\n", board.Name, board.ID)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSTATE\tSTART\tEND\tGOAL")
+	fmt.Fprintln(w, "--\t----\t-----\t-----\t---\t----")
+	for _, s := range sprints {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", s.ID, s.Name, s.State, sprintDay(s.StartDate), sprintDay(s.EndDate), commentPreview(s.Goal))
+	}
+	w.Flush()
+	return nil
+}
+
+// ExecuteSprintCreateCommand creates a future sprint
+func ExecuteSprintCreateCommand(ctx context.Context, v *viper.Viper, opts SprintOptions) error {
+	service, cfg, err := newAgileService(v)
+	if err != nil {
+		return err
+	}
+
+	board, err := service.ResolveBoard(ctx, opts.Board, cfg.JIRA.Project)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	req := jira.SprintRequest{Name: opts.Name, OriginBoardID: board.ID, Goal: opts.Goal}
+	if opts.Start != "" || opts.End != "" {
+		start, end, err := sprintRange(opts, time.Now())
+		if err != nil {
+			return err
+		}
+		req.StartDate = start.Format(time.RFC3339)
+		req.EndDate = end.Format(time.RFC3339)
+	}
+
+	sprint, err := service.CreateSprint(ctx, req)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Sprint %d created on %s: %s\n", sprint.ID, board.Name, sprint.Name)
+	return nil
+}
+
+// ExecuteSprintStartCommand starts a future sprint
+func ExecuteSprintStartCommand(ctx context.Context, v *viper.Viper, opts SprintOptions) error {
+	service, cfg, err := newAgileService(v)
+	if err != nil {
+		return err
+	}
+
+	sprint, err := resolveSprint(ctx, service, opts.Board, opts.Sprint, cfg.JIRA.Project)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	start, end, err := sprintRange(opts, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if _, err := service.StartSprint(ctx, sprint.ID, start, end); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Sprint %s started: %s to %s\n", sprint.Name, start.Format(jira.DateFormat), end.Format(jira.DateFormat))
+	return nil
+}
+
+// ExecuteSprintCloseCommand completes an active sprint
+func ExecuteSprintCloseCommand(ctx context.Context, v *viper.Viper, opts SprintOptions) error {
+	service, cfg, err := newAgileService(v)
+	if err != nil {
+		return err
+	}
+
+	sprint, err := resolveSprint(ctx, service, opts.Board, opts.Sprint, cfg.JIRA.Project)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if _, err := service.CloseSprint(ctx, sprint.ID); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Sprint %s closed\n", sprint.Name)
+	return nil
+}
+
+// ExecuteSprintAddCommand moves tickets into a sprint
+func ExecuteSprintAddCommand(ctx context.Context, v *viper.Viper, opts SprintOptions) error {
+	service, cfg, err := newAgileService(v)
+	if err != nil {
+		return err
+	}
+
+	sprint, err := resolveSprint(ctx, service, opts.Board, opts.Sprint, cfg.JIRA.Project)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if err := service.MoveToSprint(ctx, sprint.ID, opts.Keys...); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Moved %d ticket(s) to %s: %s\n", len(opts.Keys), sprint.Name, strings.Join(opts.Keys, ", "))
+	return nil
+}
+
+// ExecuteSprintRemoveCommand moves tickets back to the backlog
+func ExecuteSprintRemoveCommand(ctx context.Context, v *viper.Viper, opts SprintOptions) error {
+	service, _, err := newAgileService(v)
+	if err != nil {
+		return err
+	}
+
+	if err := service.MoveToBacklog(ctx, opts.Keys...); err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	fmt.Printf("✅ Moved %d ticket(s) to the backlog: %s\n", len(opts.Keys), strings.Join(opts.Keys, ", "))
+	return nil
+}
+
+// ExecuteSprintIssuesCommand lists the tickets in a sprint
+func ExecuteSprintIssuesCommand(ctx context.Context, v *viper.Viper, opts SprintOptions) error {
+	service, cfg, err := newAgileService(v)
+	if err != nil {
+		return err
+	}
+
+	sprint, err := resolveSprint(ctx, service, opts.Board, opts.Sprint, cfg.JIRA.Project)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	issues, err := service.GetSprintIssues(ctx, sprint.ID, []string{"summary", "status", "assignee", "issuetype"})
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+
+	if opts.Format == "json" {
+		data, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("🏃 %s (%s, %s to %s)\n\n", sprint.Name, sprint.State, sprintDay(sprint.StartDate), sprintDay(sprint.EndDate))
+	if len(issues) == 0 {
+		fmt.Println("No tickets in this sprint")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tTYPE\tSTATUS\tASSIGNEE\tSUMMARY")
+	fmt.Fprintln(w, "---\t----\t------\t--------\t-------")
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		status, assignee := "", ""
+		if issue.Fields.Status != nil {
+			status = issue.Fields.Status.Name
+		}
+		if issue.Fields.Assignee != nil {
+			assignee = userDisplayName(issue.Fields.Assignee)
+		}
+		counts[status]++
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", issue.Key, issue.Fields.IssueType.Name, status, assignee, commentPreview(issue.Fields.Summary))
+	}
+	w.Flush()
+
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	fmt.Printf("\n📊 %d ticket(s)", len(issues))
+	for _, status := range statuses {
+		fmt.Printf(" | %s: %d", status, counts[status])
+	}
+	fmt.Println()
+	return nil
+}
+
+// NewSprintCommand creates the "sprint" command group
+func NewSprintCommand() *cobra.Command {
+	var opts SprintOptions
+
+	cmd := &cobra.Command{
+		Use:   "sprint",
+		Short: "List, create, and manage sprints",
+		Long: `Manage sprints on a Scrum board through the Agile API.
+
+The board defaults to the project's only Scrum board; use --board with an ID or name
+to choose another. Sprints are given by ID, name, "active" or "next".`,
+	}
+	cmd.PersistentFlags().StringVar(&opts.Board, "board", "", "Board ID or name (default: the project's Scrum board)")
+
+	// run wraps an Execute function with flag binding
+	run := func(execute func(context.Context, *viper.Viper, SprintOptions) error) func(*cobra.Command, []string) error {
+		return func(cmd *cobra.Command, args []string) error {
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			return execute(cmd.Context(), viper.GetViper(), opts)
+		}
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the board's sprints",
+		Args:  cobra.NoArgs,
+		RunE:  run(ExecuteSprintListCommand),
+	}
+	listCmd.Flags().StringSliceVar(&opts.States, "state", []string{jira.SprintStateActive, jira.SprintStateFuture}, "Sprint states to show: active, future, closed")
+	listCmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json")
+
+	createCmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a future sprint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Name = args[0]
+			return run(ExecuteSprintCreateCommand)(cmd, args)
+		},
+	}
+	createCmd.Flags().StringVar(&opts.Goal, "goal", "", "Sprint goal")
+	createCmd.Flags().StringVar(&opts.Start, "start", "", "Planned start: YYYY-MM-DD or YYYY-MM-DD HH:MM")
+	createCmd.Flags().StringVar(&opts.End, "end", "", "Planned end (default: --weeks after the start)")
+	createCmd.Flags().IntVar(&opts.Weeks, "weeks", 2, "Sprint length in weeks when --end is not given")
+
+	startCmd := &cobra.Command{
+		Use:   "start SPRINT",
+		Short: "Start a future sprint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Sprint = args[0]
+			return run(ExecuteSprintStartCommand)(cmd, args)
+		},
+	}
+	startCmd.Flags().StringVar(&opts.Start, "start", "", "Start (default: now)")
+	startCmd.Flags().StringVar(&opts.End, "end", "", "End (default: --weeks after the start)")
+	startCmd.Flags().IntVar(&opts.Weeks, "weeks", 2, "Sprint length in weeks when --end is not given")
+
+	closeCmd := &cobra.Command{
+		Use:   "close SPRINT",
+		Short: "Complete an active sprint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Sprint = args[0]
+			return run(ExecuteSprintCloseCommand)(cmd, args)
+		},
+	}
+
+	addCmd := &cobra.Command{
+		Use:   "add KEY...",
+		Short: "Move tickets into a sprint",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Keys = args
+			return run(ExecuteSprintAddCommand)(cmd, args)
+		},
+	}
+	addCmd.Flags().StringVar(&opts.Sprint, "sprint", jira.SprintStateActive, "Target sprint: ID, name, active, or next")
+
+	removeCmd := &cobra.Command{
+		Use:   "remove KEY...",
+		Short: "Move tickets out of their sprint and into the backlog",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Keys = args
+			return run(ExecuteSprintRemoveCommand)(cmd, args)
+		},
+	}
+
+	issuesCmd := &cobra.Command{
+		Use:   "issues [SPRINT]",
+		Short: "List the tickets in a sprint (default: the active sprint)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Sprint = jira.SprintStateActive
+			if len(args) > 0 {
+				opts.Sprint = args[0]
+			}
+			return run(ExecuteSprintIssuesCommand)(cmd, args)
+		},
+	}
+	issuesCmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json")
+
+	cmd.AddCommand(listCmd, createCmd, startCmd, closeCmd, addCmd, removeCmd, issuesCmd)
+
+	return cmd
+}
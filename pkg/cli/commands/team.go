@@ -2,17 +2,24 @@ package commands
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/reports"
-	"github.com/clintonsteiner/jira-ticket-creator/internal/storage"
 )
 
+// TeamFilterOptions holds the filters shared by the team subcommands
+type TeamFilterOptions struct {
+	Project   string
+	Tickets   string // Comma-separated keys
+	Creators  string // Comma-separated emails
+	Assignees string // Comma-separated emails
+	Where     string // JQL condition and ORDER BY, evaluated locally
+}
+
 // NewTeamCommand creates the "team" command for team-based reporting
 func NewTeamCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,17 +34,10 @@ func NewTeamCommand() *cobra.Command {
 		Short: "Show ticket summary by creator",
 		Long:  "Display tickets organized by creator showing who created what and ticket status",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			projectFilter, _ := cmd.Flags().GetString("project")
-			ticketFilter, _ := cmd.Flags().GetString("ticket")
-			creatorFilter, _ := cmd.Flags().GetString("creator")
-			assigneeFilter, _ := cmd.Flags().GetString("assignee")
-			return executeTeamSummary(projectFilter, ticketFilter, creatorFilter, assigneeFilter)
+			return executeTeamSummary(readTeamFilterFlags(cmd))
 		},
 	}
-	summaryCmd.Flags().String("project", "", "Filter by project name")
-	summaryCmd.Flags().String("ticket", "", "Filter by ticket key (comma-separated, e.g., PROJ-1,PROJ-2)")
-	summaryCmd.Flags().String("creator", "", "Filter by creator email (comma-separated)")
-	summaryCmd.Flags().String("assignee", "", "Filter by assignee email (comma-separated)")
+	addTeamFilterFlags(summaryCmd)
 
 	// Assignments subcommand
 	assignCmd := &cobra.Command{
@@ -45,17 +45,10 @@ func NewTeamCommand() *cobra.Command {
 		Short: "Show workload and assignments by team member",
 		Long:  "Display tickets organized by assignee showing current workload distribution",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			projectFilter, _ := cmd.Flags().GetString("project")
-			ticketFilter, _ := cmd.Flags().GetString("ticket")
-			creatorFilter, _ := cmd.Flags().GetString("creator")
-			assigneeFilter, _ := cmd.Flags().GetString("assignee")
-			return executeAssignments(projectFilter, ticketFilter, creatorFilter, assigneeFilter)
+			return executeAssignments(readTeamFilterFlags(cmd))
 		},
 	}
-	assignCmd.Flags().String("project", "", "Filter by project name")
-	assignCmd.Flags().String("ticket", "", "Filter by ticket key (comma-separated, e.g., PROJ-1,PROJ-2)")
-	assignCmd.Flags().String("creator", "", "Filter by creator email (comma-separated)")
-	assignCmd.Flags().String("assignee", "", "Filter by assignee email (comma-separated)")
+	addTeamFilterFlags(assignCmd)
 
 	// Timeline subcommand
 	timelineCmd := &cobra.Command{
@@ -63,329 +56,107 @@ func NewTeamCommand() *cobra.Command {
 		Short: "Show project timeline and progress",
 		Long:  "Display ticket creation timeline and project progress over time",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			projectFilter, _ := cmd.Flags().GetString("project")
-			ticketFilter, _ := cmd.Flags().GetString("ticket")
-			creatorFilter, _ := cmd.Flags().GetString("creator")
-			assigneeFilter, _ := cmd.Flags().GetString("assignee")
-			return executeTimeline(projectFilter, ticketFilter, creatorFilter, assigneeFilter)
+			return executeTimeline(readTeamFilterFlags(cmd))
 		},
 	}
-	timelineCmd.Flags().String("project", "", "Filter by project name")
-	timelineCmd.Flags().String("ticket", "", "Filter by ticket key (comma-separated, e.g., PROJ-1,PROJ-2)")
-	timelineCmd.Flags().String("creator", "", "Filter by creator email (comma-separated)")
-	timelineCmd.Flags().String("assignee", "", "Filter by assignee email (comma-separated)")
+	addTeamFilterFlags(timelineCmd)
 
 	cmd.AddCommand(summaryCmd, assignCmd, timelineCmd)
 
 	return cmd
 }
 
-// executeTeamSummary shows tickets grouped by creator
-func executeTeamSummary(projectFilter string, ticketFilter string, creatorFilter string, assigneeFilter string) error {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return err
-	}
-
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
-	}
-
-	records, err := repo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
-	}
+// addTeamFilterFlags adds the filter flags shared by the team subcommands
+func addTeamFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String("project", "", "Filter by project name")
+	cmd.Flags().String("ticket", "", "Filter by ticket key (comma-separated, e.g., PROJ-1,PROJ-2)")
+	cmd.Flags().String("creator", "", "Filter by creator email (comma-separated)")
+	cmd.Flags().String("assignee", "", "Filter by assignee email (comma-separated)")
+	addWhereFlag(cmd)
+}
 
-	// Filter by ticket key(s) if specified
-	if ticketFilter != "" {
-		ticketKeys := strings.Split(ticketFilter, ",")
-		ticketMap := make(map[string]bool)
-		for _, key := range ticketKeys {
-			ticketMap[strings.TrimSpace(key)] = true
-		}
+// readTeamFilterFlags reads the filter flags shared by the team subcommands
+func readTeamFilterFlags(cmd *cobra.Command) TeamFilterOptions {
+	var f TeamFilterOptions
+	f.Project, _ = cmd.Flags().GetString("project")
+	f.Tickets, _ = cmd.Flags().GetString("ticket")
+	f.Creators, _ = cmd.Flags().GetString("creator")
+	f.Assignees, _ = cmd.Flags().GetString("assignee")
+	f.Where, _ = cmd.Flags().GetString("where")
+	return f
+}
 
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if ticketMap[r.Key] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
+// query combines the filter flags and --where into one query, so every filter
+// is evaluated with the same semantics
+func (f TeamFilterOptions) query() string {
+	q := jql.New()
+	if f.Project != "" {
+		q.Where(jql.Eq("project", jql.String(f.Project)))
+	}
+	q.Where(jql.In("key", jql.Strings(splitList(f.Tickets)...)...))
+	q.Where(jql.In("creator", jql.Strings(splitList(f.Creators)...)...))
+	q.Where(jql.In("assignee", jql.Strings(splitList(f.Assignees)...)...))
+	return q.WhereJQL(f.Where).String()
+}
 
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for keys: %s\n", ticketFilter)
-			return nil
+// splitList splits a comma-separated flag value, dropping blank items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
 		}
 	}
+	return items
+}
 
-	// Filter by creator(s) if specified
-	if creatorFilter != "" {
-		creators := strings.Split(creatorFilter, ",")
-		creatorMap := make(map[string]bool)
-		for _, creator := range creators {
-			creatorMap[strings.TrimSpace(creator)] = true
-		}
-
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if creatorMap[r.Creator] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for creators: %s\n", creatorFilter)
-			return nil
-		}
+// loadTeamTickets loads the tracked tickets matching the filters
+// When filters are given and nothing matches, it prints a note and returns false
+func loadTeamTickets(f TeamFilterOptions) ([]jira.TicketRecord, bool, error) {
+	query := f.query()
+	records, err := loadTrackedTickets(query)
+	if err != nil {
+		return nil, false, err
 	}
-
-	// Filter by assignee(s) if specified
-	if assigneeFilter != "" {
-		assignees := strings.Split(assigneeFilter, ",")
-		assigneeMap := make(map[string]bool)
-		for _, assignee := range assignees {
-			assigneeMap[strings.TrimSpace(assignee)] = true
-		}
-
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if assigneeMap[r.Assignee] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for assignees: %s\n", assigneeFilter)
-			return nil
-		}
+	if len(records) == 0 && query != "" {
+		fmt.Printf("No tickets found matching: %s\n", query)
+		return nil, false, nil
 	}
+	return records, true, nil
+}
 
-	teamReport := &reports.TeamReport{}
-	var report string
-	if projectFilter != "" {
-		report = teamReport.GenerateTeamSummaryWithFilter(records, projectFilter)
-	} else {
-		report = teamReport.GenerateTeamSummary(records)
+// executeTeamSummary shows tickets grouped by creator
+func executeTeamSummary(f TeamFilterOptions) error {
+	records, ok, err := loadTeamTickets(f)
+	if err != nil || !ok {
+		return err
 	}
 
-	fmt.Println(report)
+	teamReport := &reports.TeamReport{}
+	fmt.Println(teamReport.GenerateTeamSummary(records))
 	return nil
 }
 
 // executeAssignments shows workload assignments
-func executeAssignments(projectFilter string, ticketFilter string, creatorFilter string, assigneeFilter string) error {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
+func executeAssignments(f TeamFilterOptions) error {
+	records, ok, err := loadTeamTickets(f)
+	if err != nil || !ok {
 		return err
 	}
 
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
-	}
-
-	records, err := repo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
-	}
-
-	// Filter by ticket key(s) if specified
-	if ticketFilter != "" {
-		ticketKeys := strings.Split(ticketFilter, ",")
-		ticketMap := make(map[string]bool)
-		for _, key := range ticketKeys {
-			ticketMap[strings.TrimSpace(key)] = true
-		}
-
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if ticketMap[r.Key] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for keys: %s\n", ticketFilter)
-			return nil
-		}
-	}
-
-	// Filter by creator(s) if specified
-	if creatorFilter != "" {
-		creators := strings.Split(creatorFilter, ",")
-		creatorMap := make(map[string]bool)
-		for _, creator := range creators {
-			creatorMap[strings.TrimSpace(creator)] = true
-		}
-
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if creatorMap[r.Creator] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for creators: %s\n", creatorFilter)
-			return nil
-		}
-	}
-
-	// Filter by assignee(s) if specified
-	if assigneeFilter != "" {
-		assignees := strings.Split(assigneeFilter, ",")
-		assigneeMap := make(map[string]bool)
-		for _, assignee := range assignees {
-			assigneeMap[strings.TrimSpace(assignee)] = true
-		}
-
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if assigneeMap[r.Assignee] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for assignees: %s\n", assigneeFilter)
-			return nil
-		}
-	}
-
-	// Filter by project if specified
-	if projectFilter != "" {
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if r.Project == projectFilter {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for project: %s\n", projectFilter)
-			return nil
-		}
-	}
-
 	teamReport := &reports.TeamReport{}
-	report := teamReport.GenerateAssignmentMap(records)
-
-	fmt.Println(report)
+	fmt.Println(teamReport.GenerateAssignmentMap(records))
 	return nil
 }
 
 // executeTimeline shows project timeline
-func executeTimeline(projectFilter string, ticketFilter string, creatorFilter string, assigneeFilter string) error {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
+func executeTimeline(f TeamFilterOptions) error {
+	records, ok, err := loadTeamTickets(f)
+	if err != nil || !ok {
 		return err
 	}
 
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
-	}
-
-	records, err := repo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
-	}
-
-	// Filter by ticket key(s) if specified
-	if ticketFilter != "" {
-		ticketKeys := strings.Split(ticketFilter, ",")
-		ticketMap := make(map[string]bool)
-		for _, key := range ticketKeys {
-			ticketMap[strings.TrimSpace(key)] = true
-		}
-
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if ticketMap[r.Key] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for keys: %s\n", ticketFilter)
-			return nil
-		}
-	}
-
-	// Filter by creator(s) if specified
-	if creatorFilter != "" {
-		creators := strings.Split(creatorFilter, ",")
-		creatorMap := make(map[string]bool)
-		for _, creator := range creators {
-			creatorMap[strings.TrimSpace(creator)] = true
-		}
-
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if creatorMap[r.Creator] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for creators: %s\n", creatorFilter)
-			return nil
-		}
-	}
-
-	// Filter by assignee(s) if specified
-	if assigneeFilter != "" {
-		assignees := strings.Split(assigneeFilter, ",")
-		assigneeMap := make(map[string]bool)
-		for _, assignee := range assignees {
-			assigneeMap[strings.TrimSpace(assignee)] = true
-		}
-
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if assigneeMap[r.Assignee] {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for assignees: %s\n", assigneeFilter)
-			return nil
-		}
-	}
-
-	// Filter by project if specified
-	if projectFilter != "" {
-		filtered := make([]jira.TicketRecord, 0)
-		for _, r := range records {
-			if r.Project == projectFilter {
-				filtered = append(filtered, r)
-			}
-		}
-		records = filtered
-
-		if len(records) == 0 {
-			fmt.Printf("No tickets found for project: %s\n", projectFilter)
-			return nil
-		}
-	}
-
 	teamReport := &reports.TeamReport{}
-	report := teamReport.GenerateTimeline(records)
-
-	fmt.Println(report)
+	fmt.Println(teamReport.GenerateTimeline(records))
 	return nil
 }
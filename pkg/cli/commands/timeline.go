@@ -2,15 +2,11 @@ package commands
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
-
-	"github.com/clintonsteiner/jira-ticket-creator/internal/storage"
 )
 
 // NewTimelineCommand creates the "timeline" command for project planning
@@ -23,34 +19,25 @@ func NewTimelineCommand() *cobra.Command {
 		Short: "Generate timeline graph for project planning",
 		Long:  "Generate visual timeline showing tickets scheduled over the next weeks.",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeTimelineVisualization(weeks, outputFormat)
+			where, _ := cmd.Flags().GetString("where")
+			return executeTimelineVisualization(weeks, outputFormat, where)
 		},
 	}
 
 	cmd.Flags().IntVar(&weeks, "weeks", 2, "Number of weeks to display in the timeline (default: 2)")
 	cmd.Flags().StringVar(&outputFormat, "format", "ascii", "Output format: ascii (ASCII art), html (HTML file), mermaid (Mermaid diagram)")
+	addWhereFlag(cmd)
 
 	return cmd
 }
 
 // executeTimelineVisualization generates timeline visualization
-func executeTimelineVisualization(weeks int, format string) error {
-	homeDir, err := os.UserHomeDir()
+func executeTimelineVisualization(weeks int, format string, where string) error {
+	records, err := loadTrackedTickets(where)
 	if err != nil {
 		return err
 	}
 
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
-	}
-
-	records, err := repo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to load tickets: %w", err)
-	}
-
 	switch format {
 	case "html":
 		return generateHTMLTimeline(records, weeks)
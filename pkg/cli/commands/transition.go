@@ -1,12 +1,14 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/clintonsteiner/jira-ticket-creator/internal/batch"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
 	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
@@ -14,12 +16,19 @@ import (
 
 // TransitionOptions holds the options for the transition command
 type TransitionOptions struct {
-	Key    string
-	Status string
+	Key               string
+	Status            string
+	Comment           string
+	CommentVisibility string
+	Resolution        string
+	Fields            []string // NAME=VALUE assignments for the transition screens
+	DryRun            bool
+	Bulk              BulkOptions
 }
 
 // ExecuteTransitionCommand executes the transition command
-func ExecuteTransitionCommand(v *viper.Viper, opts TransitionOptions) error {
+// The shortest path through the workflow to the target status is run one transition at a time
+func ExecuteTransitionCommand(ctx context.Context, v *viper.Viper, opts TransitionOptions) error {
 	// Load configuration with flag overrides
 	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
@@ -31,59 +40,183 @@ func ExecuteTransitionCommand(v *viper.Viper, opts TransitionOptions) error {
 		return err
 	}
 
+	visibility, err := jira.ParseVisibility(opts.CommentVisibility)
+	if err != nil {
+		return err
+	}
+
 	// Create JIRA client and services
-	client := jira.NewClient(cfg.JIRA.URL, cfg.JIRA.Email, cfg.JIRA.Token)
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
 	issueService := jira.NewIssueService(client)
 
-	// Get available transitions
-	transitions, err := issueService.GetTransitions(opts.Key)
+	fields, err := resolveCustomFields(ctx, client, opts.Fields)
 	if err != nil {
 		cli.PrintError(err)
 		return err
 	}
 
-	// Find matching transition
-	var transitionID string
-	for _, t := range transitions {
-		if strings.EqualFold(t.To.Name, opts.Status) {
-			transitionID = t.ID
-			break
-		}
+	transitionOpts := jira.TransitionOptions{
+		Comment:           opts.Comment,
+		CommentVisibility: visibility,
+		Resolution:        opts.Resolution,
+		Fields:            fields,
 	}
 
-	if transitionID == "" {
-		// List available transitions
-		fmt.Printf("❌ Status '%s' not found\n\n", opts.Status)
-		fmt.Println("Available statuses:")
-		for _, t := range transitions {
-			fmt.Printf("  • %s\n", t.To.Name)
-		}
-		return fmt.Errorf("transition not found: %s", opts.Status)
+	if opts.Bulk.JQL != "" {
+		return executeBulkTransition(ctx, issueService, transitionOpts, opts)
+	}
+
+	// Find the shortest path to the target status
+	path, err := issueService.FindTransitionPath(ctx, opts.Key, opts.Status)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+	if len(path) == 0 {
+		fmt.Printf("✅ %s is already in %s\n", opts.Key, opts.Status)
+		return nil
+	}
+
+	printTransitionPlan(opts, path, fields)
+
+	// If dry-run, stop here
+	if opts.DryRun {
+		fmt.Println("\n✨ Dry-run complete - no transitions were made")
+		return nil
 	}
 
-	// Perform transition
-	if err := issueService.TransitionIssue(opts.Key, transitionID); err != nil {
+	err = issueService.TransitionPath(ctx, opts.Key, path, transitionOpts, func(step jira.TransitionStep) {
+		if len(path) > 1 {
+			fmt.Printf("   %s -> %s\n", step.From, step.To())
+		}
+	})
+	if err != nil {
 		cli.PrintError(err)
 		return err
 	}
 
 	// Print success message
-	fmt.Printf("✅ Ticket transitioned successfully: %s -> %s\n", opts.Key, opts.Status)
+	fmt.Printf("✅ Ticket transitioned successfully: %s -> %s\n", opts.Key, path[len(path)-1].To())
 
 	return nil
 }
 
+// executeBulkTransition transitions every ticket matching opts.Bulk.JQL to opts.Status
+// Each ticket's path is found separately, as the tickets may start in different statuses
+func executeBulkTransition(ctx context.Context, issueService *jira.IssueService, transitionOpts jira.TransitionOptions, opts TransitionOptions) error {
+	issues, err := selectBulkIssues(ctx, issueService, opts.Bulk)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+
+	// If dry-run, print each ticket's path and stop
+	if opts.DryRun {
+		results := batch.ApplyToIssues(ctx, issues, opts.Bulk.Concurrency, func(ctx context.Context, issue jira.Issue) (string, error) {
+			path, err := issueService.FindTransitionPath(ctx, issue.Key, opts.Status)
+			return describeTransitionPath(path, opts.Status), err
+		})
+
+		fmt.Printf("📋 Paths to %s:\n", opts.Status)
+		for _, result := range results {
+			if result.Error != nil {
+				fmt.Printf("❌ %s: %v\n", result.Key, result.Error)
+				continue
+			}
+			fmt.Printf("  %s: %s\n", result.Key, result.Detail)
+		}
+		fmt.Println("\n✨ Dry-run complete - no transitions were made")
+		return nil
+	}
+
+	if !confirmBulk("Transition to "+opts.Status, len(issues), opts.Bulk) {
+		return nil
+	}
+
+	return runBulk(ctx, issues, opts.Bulk, func(ctx context.Context, issue jira.Issue) (string, error) {
+		path, err := issueService.FindTransitionPath(ctx, issue.Key, opts.Status)
+		if err != nil {
+			return "", err
+		}
+		if err := issueService.TransitionPath(ctx, issue.Key, path, transitionOpts, nil); err != nil {
+			return "", err
+		}
+		return describeTransitionPath(path, opts.Status), nil
+	})
+}
+
+// describeTransitionPath returns the statuses along a path, e.g. "To Do -> In Progress -> Done"
+func describeTransitionPath(path []jira.TransitionStep, status string) string {
+	if len(path) == 0 {
+		return "already in " + status
+	}
+	statuses := []string{path[0].From}
+	for _, step := range path {
+		statuses = append(statuses, step.To())
+	}
+	return strings.Join(statuses, " -> ")
+}
+
+// printTransitionPlan prints the transitions of a multi-step path, warning about
+// required screen fields that will not be set
+func printTransitionPlan(opts TransitionOptions, path []jira.TransitionStep, fields map[string]interface{}) {
+	set := map[string]bool{"resolution": opts.Resolution != "", "comment": opts.Comment != ""}
+	for id := range fields {
+		set[id] = true
+	}
+
+	if len(path) > 1 || opts.DryRun {
+		fmt.Printf("📋 %s: %d transition(s) from %s to %s\n", opts.Key, len(path), path[0].From, path[len(path)-1].To())
+		for i, step := range path {
+			fmt.Printf("  %d. %s -> %s (%s)\n", i+1, step.From, step.To(), step.Transition.Name)
+		}
+	}
+
+	for _, step := range path {
+		if required := step.RequiredFields(set); len(required) > 0 {
+			fmt.Printf("⚠️  %s -> %s requires: %s\n", step.From, step.To(), strings.Join(required, ", "))
+		}
+	}
+}
+
 // NewTransitionCommand creates the "transition" command with full implementation
 func NewTransitionCommand() *cobra.Command {
 	var opts TransitionOptions
 
 	cmd := &cobra.Command{
-		Use:   "transition KEY",
+		Use:   "transition [KEY]",
 		Short: "Transition a ticket to a new status",
-		Long:  "Transition a JIRA ticket to a new workflow status.",
-		Args:  cobra.ExactArgs(1),
+		Long: `Transition a JIRA ticket to a new workflow status.
+
+When the status is not directly reachable, the shortest sequence of transitions
+through the workflow is found and run in order; --dry-run prints the plan only.
+The comment is added on the last transition. The resolution and --field values
+are set on the last transition and on any earlier one whose screen has them.
+
+With --jql, every ticket matching the query is transitioned, several at a time.
+The matching tickets are listed and confirmed first; --dry-run prints each
+ticket's path only.
+
+Examples:
+  # Close one ticket, passing through any intermediate statuses
+  transition PROJ-123 --to Done --resolution Fixed
+
+  # Close out the sprint
+  transition --jql "sprint in openSprints() AND status = 'In Review'" --to Done`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.Key = args[0]
+			if err := checkKeyOrJQL(args, opts.Bulk); err != nil {
+				return err
+			}
+			if len(args) > 0 {
+				opts.Key = args[0]
+			}
 
 			// Bind flags to viper
 			if err := viper.BindPFlags(cmd.Flags()); err != nil {
@@ -92,16 +225,27 @@ func NewTransitionCommand() *cobra.Command {
 
 			// Read values from flags
 			opts.Status, _ = cmd.Flags().GetString("to")
+			opts.Comment, _ = cmd.Flags().GetString("comment")
+			opts.CommentVisibility, _ = cmd.Flags().GetString("comment-visibility")
+			opts.Resolution, _ = cmd.Flags().GetString("resolution")
+			opts.Fields, _ = cmd.Flags().GetStringArray("field")
+			opts.DryRun, _ = cmd.Flags().GetBool("dry-run")
 
 			if opts.Status == "" {
 				return fmt.Errorf("--to flag is required")
 			}
 
-			return ExecuteTransitionCommand(viper.GetViper(), opts)
+			return ExecuteTransitionCommand(cmd.Context(), viper.GetViper(), opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.Status, "to", "", "Target status to transition to (REQUIRED). Use available statuses from your workflow (e.g., 'In Progress', 'Done', 'Review')")
+	cmd.Flags().StringVar(&opts.Comment, "comment", "", "Comment to add as part of the transition (Markdown)")
+	cmd.Flags().StringVar(&opts.CommentVisibility, "comment-visibility", "", "Restrict the comment to a project role or group (role:NAME or group:NAME)")
+	cmd.Flags().StringVar(&opts.Resolution, "resolution", "", "Resolution to set, e.g. Done, \"Won't Do\", Duplicate")
+	cmd.Flags().StringArrayVar(&opts.Fields, "field", []string{}, "Set a field on the transition screen by name or ID, as NAME=VALUE (repeatable)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the transitions that would be made without making them")
+	addBulkFlags(cmd, &opts.Bulk)
 
 	return cmd
 }
@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -13,16 +15,32 @@ import (
 
 // UpdateOptions holds the options for the update command
 type UpdateOptions struct {
-	Key         string
-	Summary     string
-	Description string
-	Priority    string
-	Assignee    string
-	Labels      []string
+	Key               string
+	Summary           string
+	Description       string
+	Priority          string
+	Assignee          string
+	Unassign          bool
+	Labels            []string // Replaces the ticket's labels
+	AddLabels         []string
+	RemoveLabels      []string
+	AddComponents     []string
+	RemoveComponents  []string
+	DescriptionFormat string
+	Fields            []string // "Name=value", e.g. "Story Points=5"
+	Clear             []string // Field names or IDs to empty, e.g. "description"
+	FixVersions       []string // Replaces the ticket's fix versions
+	AddFixVersions    []string
+	RemoveFixVersions []string
+	AffectsVersions   []string // Replaces the ticket's affected versions
+	Diff              bool
+	DryRun            bool
+	Bulk              BulkOptions
 }
 
 // ExecuteUpdateCommand executes the update command
-func ExecuteUpdateCommand(v *viper.Viper, opts UpdateOptions) error {
+// Only the fields given are sent, so everything else on the ticket is left as it is
+func ExecuteUpdateCommand(ctx context.Context, v *viper.Viper, opts UpdateOptions) error {
 	// Load configuration with flag overrides
 	cfg, err := config.LoadConfigWithFlags(v)
 	if err != nil {
@@ -34,39 +52,59 @@ func ExecuteUpdateCommand(v *viper.Viper, opts UpdateOptions) error {
 		return err
 	}
 
+	if opts.Assignee != "" && opts.Unassign {
+		return fmt.Errorf("--assignee and --unassign cannot be used together")
+	}
+	if opts.Diff && opts.Bulk.JQL != "" {
+		return fmt.Errorf("--diff works with a single ticket KEY, not --jql")
+	}
+
 	// Create JIRA client and services
-	client := jira.NewClient(cfg.JIRA.URL, cfg.JIRA.Email, cfg.JIRA.Token)
+	client, err := newJiraClient(cfg)
+	if err != nil {
+		return err
+	}
+	if err := setDescriptionFormat(client, opts.DescriptionFormat); err != nil {
+		return err
+	}
 	issueService := jira.NewIssueService(client)
 
-	// Build update fields - only include non-empty values
-	fields := jira.IssueFields{}
-
-	if opts.Summary != "" {
-		fields.Summary = opts.Summary
+	builder := &updateBuilder{
+		opts:     opts,
+		fields:   jira.NewFieldService(client),
+		users:    jira.NewUserService(client),
+		versions: jira.NewVersionService(client),
+	}
+	if err := builder.resolveFields(ctx); err != nil {
+		return err
 	}
 
-	if opts.Description != "" {
-		fields.Description = opts.Description
+	if opts.Bulk.JQL != "" {
+		return executeBulkUpdate(ctx, issueService, builder, opts)
 	}
 
-	if opts.Priority != "" {
-		fields.Priority = &jira.Priority{
-			Name: opts.Priority,
-		}
+	update, err := builder.build(ctx, opts.Key)
+	if err != nil {
+		return err
 	}
 
-	if opts.Assignee != "" {
-		fields.Assignee = &jira.User{
-			EmailAddress: opts.Assignee,
+	if opts.Diff {
+		changes, err := issueService.DiffUpdate(ctx, opts.Key, update)
+		if err != nil {
+			cli.PrintError(err)
+			return err
 		}
+		printFieldChanges(opts.Key, changes)
 	}
 
-	if len(opts.Labels) > 0 {
-		fields.Labels = opts.Labels
+	// If dry-run, stop here
+	if opts.DryRun {
+		fmt.Printf("\n✨ Dry-run complete - %s was not updated\n", opts.Key)
+		return nil
 	}
 
 	// Update the issue
-	if err := issueService.UpdateIssue(opts.Key, fields); err != nil {
+	if err := issueService.EditIssue(ctx, opts.Key, update); err != nil {
 		cli.PrintError(err)
 		return err
 	}
@@ -77,17 +115,252 @@ func ExecuteUpdateCommand(v *viper.Viper, opts UpdateOptions) error {
 	return nil
 }
 
+// executeBulkUpdate updates every ticket matching opts.Bulk.JQL
+func executeBulkUpdate(ctx context.Context, issueService *jira.IssueService, builder *updateBuilder, opts UpdateOptions) error {
+	issues, err := selectBulkIssues(ctx, issueService, opts.Bulk)
+	if err != nil {
+		cli.PrintError(err)
+		return err
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+
+	// If dry-run, stop here
+	if opts.DryRun {
+		fmt.Println("✨ Dry-run complete - no tickets were updated")
+		return nil
+	}
+
+	if !confirmBulk("Update", len(issues), opts.Bulk) {
+		return nil
+	}
+
+	return runBulk(ctx, issues, opts.Bulk, func(ctx context.Context, issue jira.Issue) (string, error) {
+		update, err := builder.build(ctx, issue.Key)
+		if err != nil {
+			return "", err
+		}
+		return "", issueService.EditIssue(ctx, issue.Key, update)
+	})
+}
+
+// printFieldChanges prints the old and new values of the fields an update changes
+func printFieldChanges(key string, changes []jira.FieldChange) {
+	if len(changes) == 0 {
+		fmt.Printf("📋 No changes to %s\n", key)
+		return
+	}
+
+	fmt.Printf("📋 Changes to %s:\n", key)
+	for _, change := range changes {
+		if strings.Contains(change.Old, "\n") || strings.Contains(change.New, "\n") {
+			fmt.Printf("  %s:\n", change.Field)
+			printDiffLines("-", change.Old)
+			printDiffLines("+", change.New)
+			continue
+		}
+		fmt.Printf("  %s: %s -> %s\n", change.Field, emptyAsNone(change.Old), emptyAsNone(change.New))
+	}
+}
+
+// printDiffLines prints each line of a multi-line value with a prefix
+func printDiffLines(prefix, value string) {
+	if value == "" {
+		fmt.Printf("    %s (none)\n", prefix)
+		return
+	}
+	for _, line := range strings.Split(value, "\n") {
+		fmt.Printf("    %s %s\n", prefix, line)
+	}
+}
+
+// emptyAsNone returns "(none)" for an empty value
+func emptyAsNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
+
+// updateBuilder builds the update applied to each ticket
+// The assignee and versions are resolved in each ticket's own project; the services
+// are shared so each is looked up once per project
+type updateBuilder struct {
+	opts     UpdateOptions
+	fields   *jira.FieldService
+	users    *jira.UserService
+	versions *jira.VersionService
+
+	resolved map[string]interface{} // --field and --clear values, keyed by field ID
+}
+
+// resolveFields resolves the --field and --clear field names, which are the same for every ticket
+func (b *updateBuilder) resolveFields(ctx context.Context) error {
+	assignments, err := jira.ParseFieldAssignments(b.opts.Fields)
+	if err != nil {
+		return err
+	}
+	if b.resolved, err = b.fields.ResolveFieldValues(ctx, assignments); err != nil {
+		return err
+	}
+
+	for _, name := range b.opts.Clear {
+		field, err := b.fields.ResolveField(ctx, name)
+		if err != nil {
+			return err
+		}
+		if _, ok := b.resolved[field.ID]; ok {
+			return fmt.Errorf("cannot both set and clear %s", field.Name)
+		}
+		if b.resolved == nil {
+			b.resolved = make(map[string]interface{})
+		}
+
+		// Multi-value fields are emptied; others are unset
+		var empty interface{}
+		if field.Schema != nil && field.Schema.Type == "array" {
+			empty = []interface{}{}
+		}
+		b.resolved[field.ID] = empty
+	}
+	return nil
+}
+
+// build builds the update for the ticket with the given key
+func (b *updateBuilder) build(ctx context.Context, key string) (jira.IssueUpdate, error) {
+	opts := b.opts
+	var update jira.IssueUpdate
+
+	for id, value := range b.resolved {
+		update.Set(id, value)
+	}
+
+	if opts.Summary != "" {
+		update.Set("summary", opts.Summary)
+	}
+	if opts.Description != "" {
+		update.Set("description", opts.Description)
+	}
+	if opts.Priority != "" {
+		update.Set("priority", &jira.Priority{Name: opts.Priority})
+	}
+	if opts.Unassign {
+		update.Set("assignee", nil)
+	}
+
+	if len(opts.Labels) > 0 {
+		update.Set("labels", opts.Labels)
+	}
+	for _, label := range opts.AddLabels {
+		update.Add("labels", label)
+	}
+	for _, label := range opts.RemoveLabels {
+		update.Remove("labels", label)
+	}
+
+	for _, name := range opts.AddComponents {
+		update.Add("components", jira.Component{Name: name})
+	}
+	for _, name := range opts.RemoveComponents {
+		update.Remove("components", jira.Component{Name: name})
+	}
+
+	if err := update.Validate(); err != nil {
+		return update, err
+	}
+
+	// The assignee and versions depend on the ticket's project, which may not be the configured one
+	if opts.Assignee != "" || len(opts.FixVersions)+len(opts.AddFixVersions)+len(opts.RemoveFixVersions)+len(opts.AffectsVersions) > 0 {
+		if err := b.buildProjectFields(ctx, key, &update); err != nil {
+			return update, err
+		}
+	}
+
+	if update.IsEmpty() {
+		return update, fmt.Errorf("nothing to update: give at least one field to change")
+	}
+	return update, update.Validate()
+}
+
+// buildProjectFields adds the assignee and versions, resolved in the ticket's project
+func (b *updateBuilder) buildProjectFields(ctx context.Context, key string, update *jira.IssueUpdate) error {
+	opts := b.opts
+	projectKey, err := jira.ExtractProjectKey(key)
+	if err != nil {
+		return err
+	}
+
+	if opts.Assignee != "" {
+		// The full user is kept so --diff can show the name; only a reference is sent
+		user, err := b.users.ResolveUser(ctx, opts.Assignee, projectKey)
+		if err != nil {
+			return err
+		}
+		update.Set("assignee", user)
+	}
+
+	if len(opts.FixVersions) > 0 {
+		versions, err := b.versions.ResolveVersions(ctx, projectKey, opts.FixVersions)
+		if err != nil {
+			return err
+		}
+		update.Set("fixVersions", versions)
+	}
+	if len(opts.AffectsVersions) > 0 {
+		versions, err := b.versions.ResolveVersions(ctx, projectKey, opts.AffectsVersions)
+		if err != nil {
+			return err
+		}
+		update.Set("versions", versions)
+	}
+
+	added, err := b.versions.ResolveVersions(ctx, projectKey, opts.AddFixVersions)
+	if err != nil {
+		return err
+	}
+	for _, version := range added {
+		update.Add("fixVersions", version)
+	}
+	for _, name := range opts.RemoveFixVersions {
+		update.Remove("fixVersions", jira.Version{Name: name})
+	}
+	return nil
+}
+
 // NewUpdateCommand creates the "update" command with full implementation
 func NewUpdateCommand() *cobra.Command {
 	var opts UpdateOptions
 
 	cmd := &cobra.Command{
-		Use:   "update KEY",
+		Use:   "update [KEY]",
 		Short: "Update a JIRA ticket",
-		Long:  "Update an existing JIRA ticket with new values.",
-		Args:  cobra.ExactArgs(1),
+		Long: `Update an existing JIRA ticket with new values. Only the fields given are
+changed; --labels, --fix-version and --affects-version replace every value,
+while the --add-* and --remove-* flags change one value at a time. --clear
+empties a field by name or ID, and --diff shows the current and new values
+before updating (with --dry-run, without updating).
+
+With --jql, every ticket matching the query is updated, several at a time. The
+matching tickets are listed and confirmed first; --dry-run lists them only.
+
+Examples:
+  # Raise the priority of one ticket
+  update PROJ-123 --priority High
+
+  # Swap a label and clear the description, previewing the change
+  update PROJ-123 --add-label frontend --remove-label backend --clear description --diff --dry-run
+
+  # Label every finished ticket in the open sprints
+  update --jql "sprint in openSprints() AND status = Done" --labels shipped`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.Key = args[0]
+			if err := checkKeyOrJQL(args, opts.Bulk); err != nil {
+				return err
+			}
+			if len(args) > 0 {
+				opts.Key = args[0]
+			}
 
 			// Bind flags to viper
 			if err := viper.BindPFlags(cmd.Flags()); err != nil {
@@ -100,16 +373,44 @@ func NewUpdateCommand() *cobra.Command {
 			opts.Priority, _ = cmd.Flags().GetString("priority")
 			opts.Assignee, _ = cmd.Flags().GetString("assignee")
 			opts.Labels, _ = cmd.Flags().GetStringSlice("labels")
+			opts.DescriptionFormat, _ = cmd.Flags().GetString("description-format")
+			opts.Fields, _ = cmd.Flags().GetStringArray("field")
+			opts.FixVersions, _ = cmd.Flags().GetStringSlice("fix-version")
+			opts.AffectsVersions, _ = cmd.Flags().GetStringSlice("affects-version")
+			opts.Unassign, _ = cmd.Flags().GetBool("unassign")
+			opts.AddLabels, _ = cmd.Flags().GetStringSlice("add-label")
+			opts.RemoveLabels, _ = cmd.Flags().GetStringSlice("remove-label")
+			opts.AddComponents, _ = cmd.Flags().GetStringSlice("add-component")
+			opts.RemoveComponents, _ = cmd.Flags().GetStringSlice("remove-component")
+			opts.AddFixVersions, _ = cmd.Flags().GetStringSlice("add-fix-version")
+			opts.RemoveFixVersions, _ = cmd.Flags().GetStringSlice("remove-fix-version")
+			opts.Clear, _ = cmd.Flags().GetStringArray("clear")
+			opts.Diff, _ = cmd.Flags().GetBool("diff")
 
-			return ExecuteUpdateCommand(viper.GetViper(), opts)
+			return ExecuteUpdateCommand(cmd.Context(), viper.GetViper(), opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.Summary, "summary", "", "New ticket summary")
 	cmd.Flags().StringVar(&opts.Description, "description", "", "New ticket description")
 	cmd.Flags().StringVar(&opts.Priority, "priority", "", "New priority level (Lowest, Low, Medium, High, Highest)")
-	cmd.Flags().StringVar(&opts.Assignee, "assignee", "", "New assignee email (e.g., user@company.com)")
-	cmd.Flags().StringSliceVar(&opts.Labels, "labels", []string{}, "New labels (comma-separated, e.g., --labels bug,review)")
+	cmd.Flags().StringVar(&opts.Assignee, "assignee", "", assigneeFlagUsage)
+	cmd.Flags().BoolVar(&opts.Unassign, "unassign", false, "Remove the assignee")
+	cmd.Flags().StringSliceVar(&opts.Labels, "labels", []string{}, "Replace all labels (comma-separated, e.g., --labels bug,review)")
+	cmd.Flags().StringSliceVar(&opts.AddLabels, "add-label", []string{}, "Add label(s), keeping the others (comma-separated or repeatable)")
+	cmd.Flags().StringSliceVar(&opts.RemoveLabels, "remove-label", []string{}, "Remove label(s) (comma-separated or repeatable)")
+	cmd.Flags().StringSliceVar(&opts.AddComponents, "add-component", []string{}, "Add component(s) by name (comma-separated or repeatable)")
+	cmd.Flags().StringSliceVar(&opts.RemoveComponents, "remove-component", []string{}, "Remove component(s) by name (comma-separated or repeatable)")
+	cmd.Flags().StringVar(&opts.DescriptionFormat, "description-format", jira.DescriptionFormatMarkdown, descriptionFormatUsage)
+	cmd.Flags().StringArrayVar(&opts.Fields, "field", []string{}, fieldFlagUsage)
+	cmd.Flags().StringArrayVar(&opts.Clear, "clear", []string{}, "Empty a field by name or ID, e.g. --clear description (repeatable)")
+	cmd.Flags().StringSliceVar(&opts.FixVersions, "fix-version", []string{}, "Replace the fix versions by name (comma-separated or repeatable)")
+	cmd.Flags().StringSliceVar(&opts.AddFixVersions, "add-fix-version", []string{}, "Add fix version(s) by name (comma-separated or repeatable)")
+	cmd.Flags().StringSliceVar(&opts.RemoveFixVersions, "remove-fix-version", []string{}, "Remove fix version(s) by name (comma-separated or repeatable)")
+	cmd.Flags().StringSliceVar(&opts.AffectsVersions, "affects-version", []string{}, affectsVersionFlagUsage)
+	cmd.Flags().BoolVar(&opts.Diff, "diff", false, "Show the current and new value of each changed field before updating")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Resolve the new values and list the tickets to update without updating them")
+	addBulkFlags(cmd, &opts.Bulk)
 
 	return cmd
 }
@@ -3,11 +3,11 @@ package commands
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/reports"
 	"github.com/clintonsteiner/jira-ticket-creator/internal/storage"
 	"github.com/clintonsteiner/jira-ticket-creator/pkg/cli"
@@ -17,6 +17,23 @@ import (
 type VisualizeOptions struct {
 	Format string
 	Output string
+	Where  string // Only tickets matching this JQL condition
+}
+
+// filteredRepository is a repository whose tickets are limited to those matching a --where query
+type filteredRepository struct {
+	storage.Repository
+	records []jira.TicketRecord
+}
+
+// GetAll returns the matching tickets
+func (r filteredRepository) GetAll() ([]jira.TicketRecord, error) {
+	return r.records, nil
+}
+
+// Load returns the matching tickets
+func (r filteredRepository) Load() ([]jira.TicketRecord, error) {
+	return r.records, nil
 }
 
 // ExecuteVisualizeCommand executes the visualize command
@@ -24,19 +41,26 @@ func ExecuteVisualizeCommand(v *viper.Viper, opts VisualizeOptions) error {
 	// No configuration needed for visualization, just load local storage
 
 	// Load ticket records from storage
-	homeDir, err := os.UserHomeDir()
+	repo, err := openTrackedTickets()
 	if err != nil {
 		return err
 	}
 
-	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
-	repo, err := storage.NewJSONRepository(recordFile)
-	if err != nil {
-		return fmt.Errorf("failed to initialize storage: %w", err)
+	var source storage.Repository = repo
+	if opts.Where != "" {
+		records, err := repo.GetAll()
+		if err != nil {
+			return fmt.Errorf("failed to load tickets: %w", err)
+		}
+		if records, err = filterTrackedTickets(records, opts.Where); err != nil {
+			cli.PrintError(err)
+			return err
+		}
+		source = filteredRepository{Repository: repo, records: records}
 	}
 
 	// Create visualizer
-	visualizer := reports.NewVisualizer(repo)
+	visualizer := reports.NewVisualizer(source)
 
 	// Check for circular dependencies
 	cycles, err := visualizer.DetectCircularDependencies()
@@ -118,6 +142,7 @@ func NewVisualizeCommand() *cobra.Command {
 			// Read values from flags
 			opts.Format, _ = cmd.Flags().GetString("format")
 			opts.Output, _ = cmd.Flags().GetString("output")
+			opts.Where, _ = cmd.Flags().GetString("where")
 
 			return ExecuteVisualizeCommand(viper.GetViper(), opts)
 		},
@@ -125,6 +150,7 @@ func NewVisualizeCommand() *cobra.Command {
 
 	cmd.Flags().StringVar(&opts.Format, "format", "tree", "Output format: tree (ASCII tree), mermaid (Mermaid diagram), dot (Graphviz DOT format)")
 	cmd.Flags().StringVar(&opts.Output, "output", "", "Output file path (optional, default: print to stdout)")
+	addWhereFlag(cmd)
 
 	return cmd
 }
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/clintonsteiner/jira-ticket-creator/internal/config"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jira"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/jql"
+	"github.com/clintonsteiner/jira-ticket-creator/internal/storage"
+)
+
+// addWhereFlag adds --where to a command that reports on tracked tickets
+func addWhereFlag(cmd *cobra.Command) {
+	cmd.Flags().String("where", "", `Only tracked tickets matching a JQL condition, optionally with ORDER BY (e.g., "status != Done AND assignee in (a,b) ORDER BY priority")`)
+}
+
+// openTrackedTickets opens the local store of tracked tickets
+func openTrackedTickets() (*storage.JSONRepository, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	recordFile := filepath.Join(homeDir, ".jira", "tickets.json")
+	repo, err := storage.NewJSONRepository(recordFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize storage: %w", err)
+	}
+	return repo, nil
+}
+
+// loadTrackedTickets loads the tracked tickets matching a --where query, sorted by its ORDER BY
+func loadTrackedTickets(where string) ([]jira.TicketRecord, error) {
+	repo, err := openTrackedTickets()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := repo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tickets: %w", err)
+	}
+
+	return filterTrackedTickets(records, where)
+}
+
+// filterTrackedTickets keeps the records matching a --where query and sorts them by its ORDER BY
+// currentUser() is the configured JIRA email
+func filterTrackedTickets(records []jira.TicketRecord, where string) ([]jira.TicketRecord, error) {
+	if strings.TrimSpace(where) == "" {
+		return records, nil
+	}
+
+	stmt, err := jql.Parse(where)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --where: %w", err)
+	}
+
+	var env jql.Env
+	if cfg, err := config.LoadConfig(); err == nil {
+		env.CurrentUser = cfg.JIRA.Email
+	}
+
+	records, err = jql.Filter(stmt, records, env)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --where: %w", err)
+	}
+	return records, nil
+}
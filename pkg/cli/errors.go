@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -11,6 +13,12 @@ import (
 func FormatError(err error) string {
 	var sb strings.Builder
 
+	if errors.Is(err, context.Canceled) {
+		sb.WriteString("⚠️  Operation Cancelled\n")
+		sb.WriteString("   Pending requests and retries were stopped\n")
+		return sb.String()
+	}
+
 	switch e := err.(type) {
 	case *jira.AuthenticationError:
 		sb.WriteString("❌ Authentication Failed\n")
@@ -21,6 +29,7 @@ func FormatError(err error) string {
 		sb.WriteString("   • Ensure your API token has the necessary permissions\n")
 		sb.WriteString("   • Try setting: export JIRA_EMAIL=your-email\n")
 		sb.WriteString("   • Try setting: export JIRA_TOKEN=your-token\n")
+		sb.WriteString("   • For Jira Server/Data Center personal access tokens, set: export JIRA_AUTH_TYPE=bearer\n")
 
 	case *jira.NotFoundError:
 		sb.WriteString("❌ Resource Not Found\n")
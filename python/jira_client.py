@@ -203,7 +203,8 @@ class JiraClient:
                 - issue_type: Filter by issue type
 
         Returns:
-            Dictionary with search results: total, count, tickets
+            Dictionary with search results: total, count, tickets.
+            Every matching ticket is returned.
         """
         # Build JQL if kwargs provided
         if not jql and kwargs: